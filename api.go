@@ -1,17 +1,22 @@
 package gotable
 
 import (
-	"encoding/csv"
+	"bytes"
+	"database/sql"
 	"encoding/json"
 	"fmt"
 	"github.com/liushuochen/gotable/constant"
 	"github.com/liushuochen/gotable/exception"
 	"github.com/liushuochen/gotable/table"
 	"github.com/liushuochen/gotable/util"
-	"io/ioutil"
+	"github.com/liushuochen/gotable/xlsx"
+	"io"
 	"os"
 	"reflect"
+	"sort"
+	"strconv"
 	"strings"
+	"time"
 )
 
 const (
@@ -21,6 +26,48 @@ const (
 	Default = table.Default
 )
 
+// SQL dialects, used with the *table.ToSQL method
+const (
+	StandardSQL = table.StandardSQL
+	MySQL       = table.MySQL
+)
+
+// Column validators, used with the *table.SetColumnType method
+var (
+	IntColumn    = table.IntColumn
+	FloatColumn  = table.FloatColumn
+	DateColumn   = table.DateColumn
+	RegexpColumn = table.RegexpColumn
+)
+
+// Header formatters, used with the *table.SetHeaderFormatter method
+var (
+	UpperHeader = table.UpperHeader
+	TitleHeader = table.TitleHeader
+)
+
+// JSON options, used with the *table.JsonWithOptions method
+var (
+	OrderedKeys = table.OrderedKeys
+	OmitEmpty   = table.OmitEmpty
+)
+
+// Org mode options, used with the *table.OrgMode method
+var (
+	AlignmentCookies = table.AlignmentCookies
+)
+
+// Column formats, used with the *table.SetColumnFormat method
+var (
+	NumberFormat = table.NumberFormat
+)
+
+// CSV write options, used with the *table.ToCSVFile method
+var (
+	AlwaysQuote = table.AlwaysQuote
+	UseCRLF     = table.UseCRLF
+)
+
 // Colored display control
 const (
 	TerminalDefault = 0
@@ -47,6 +94,7 @@ const (
 // It will return a table pointer and an error.
 // Error:
 // - If the length of columns is not greater than 0, an *exception.ColumnsLengthError error is returned.
+// - If a column is empty or only holds whitespace, an *exception.EmptyColumnNameError error is returned.
 // - If columns contain duplicate values, an error is returned.
 // - Otherwise, the value of error is nil.
 func Create(columns ...string) (*table.Table, error) {
@@ -65,37 +113,173 @@ func Create(columns ...string) (*table.Table, error) {
 	return tb, nil
 }
 
+// New creates an empty table like Create, to be configured with the chainable methods of the table, e.g.
+// gotable.New("a", "b").WithAlign("a", gotable.Right).Border(false).
+// It returns no error, so that the chain can start from it: if the columns are invalid, that is if Create returns an
+// error, the table has no column and its Err method returns the error of Create.
+func New(columns ...string) *table.Table {
+	tb, err := Create(columns...)
+	if err != nil {
+		return table.CreateFailedTable(err)
+	}
+	return tb
+}
 
-// CreateByStruct creates an empty table from struct. You can rename a field using struct tag: gotable
+// MustNew creates an empty table like New, but panics if the columns are invalid, that is if Create returns an error:
+// no column, an empty column or duplicate columns.
+func MustNew(columns ...string) *table.Table {
+	tb, err := Create(columns...)
+	if err != nil {
+		panic(err)
+	}
+	return tb
+}
+
+// CreateByStruct creates an empty table from a pointer to struct. You can rename a field using struct tag: gotable
+// Unexported fields and fields tagged `gotable:"-"` are skipped, and the fields of an embedded struct without a tag
+// are flattened into columns.
 // It will return a table pointer and an error.
 // Error:
+// - If v is not a pointer to struct, an *exception.NotAStructPointerError error is returned.
 // - If the length of columns is not greater than 0, an *exception.ColumnsLengthError error is returned.
-// - If columns contain duplicate values, an error is returned.
+// - If several fields are mapped to the same column, an *exception.DuplicateStructColumnError error is returned.
 // - Otherwise, the value of error is nil.
 func CreateByStruct(v interface{}) (*table.Table, error) {
-	set := &table.Set{}
-	s := reflect.TypeOf(v).Elem()
-	numField := s.NumField()
-	if numField <= 0 {
+	t := reflect.TypeOf(v)
+	if t == nil || t.Kind() != reflect.Ptr || t.Elem().Kind() != reflect.Struct {
+		return nil, exception.NotAStructPointer(v)
+	}
+	columns, err := util.StructColumns(t.Elem())
+	if err != nil {
+		return nil, err
+	}
+	if len(columns) <= 0 {
 		return nil, exception.ColumnsLength()
 	}
 
-	for i := 0; i < numField; i++ {
-		field := s.Field(i)
-		name := field.Tag.Get("gotable")
-		if name == "" {
-			name = field.Name
+	set := &table.Set{}
+	for _, column := range columns {
+		err = set.Add(column.Name)
+		if err != nil {
+			return nil, err
 		}
+	}
+	tb := table.CreateTable(set)
+	return tb, nil
+}
 
-		err := set.Add(name)
+// FromStructs creates a table from a slice of structs or of pointers to struct. The columns are derived from the
+// element type as CreateByStruct does, so an empty slice gives an empty table with the columns, and every element is
+// added as a row. Values are converted to strings as FromStructKV does and nil elements are skipped.
+// Error:
+// - If v is not a slice of struct or of pointer to struct, an *exception.NotAStructSliceError error is returned.
+// - CreateByStruct errors are returned for the element type.
+func FromStructs(slice interface{}) (*table.Table, error) {
+	value := reflect.ValueOf(slice)
+	if value.Kind() != reflect.Slice && value.Kind() != reflect.Array {
+		return nil, exception.NotAStructSlice(slice)
+	}
+	t := value.Type().Elem()
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return nil, exception.NotAStructSlice(slice)
+	}
+
+	tb, err := CreateByStruct(reflect.New(t).Interface())
+	if err != nil {
+		return nil, err
+	}
+	columns, err := util.StructColumns(t)
+	if err != nil {
+		return nil, err
+	}
+	for i := 0; i < value.Len(); i++ {
+		element := value.Index(i)
+		if element.Kind() == reflect.Ptr {
+			if element.IsNil() {
+				continue
+			}
+			element = element.Elem()
+		}
+
+		row := make([]string, 0)
+		for _, column := range columns {
+			s := ""
+			if field, ok := util.FieldByIndex(element, column.Index); ok {
+				s = stringify(field)
+			}
+			row = append(row, s)
+		}
+		err = tb.AddRow(row)
 		if err != nil {
 			return nil, err
 		}
 	}
-	tb := table.CreateTable(set)
 	return tb, nil
 }
 
+// FromStructKV creates a two-column table ("Field", "Value") from a struct or a pointer to struct, with one row per
+// exported field. The field name can be renamed using struct tag: gotable, and a field tagged `gotable:"-"` is
+// skipped. Values are converted to strings: a
+// time.Time is formatted as RFC 3339, a fmt.Stringer uses its String method and a nil pointer is an empty string.
+func FromStructKV(v interface{}) (*table.Table, error) {
+	value := reflect.ValueOf(v)
+	for value.Kind() == reflect.Ptr {
+		value = value.Elem()
+	}
+	if value.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("%T is not a struct or a pointer to struct", v)
+	}
+
+	tb, err := Create("Field", "Value")
+	if err != nil {
+		return nil, err
+	}
+	columns, err := util.StructColumns(value.Type())
+	if err != nil {
+		return nil, err
+	}
+	for _, column := range columns {
+		s := ""
+		if field, ok := util.FieldByIndex(value, column.Index); ok {
+			s = stringify(field)
+		}
+		err = tb.AddRow([]string{column.Name, s})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return tb, nil
+}
+
+// stringify converts a field value to the string stored in a table cell.
+func stringify(value reflect.Value) string {
+	for value.Kind() == reflect.Ptr || value.Kind() == reflect.Interface {
+		if value.IsNil() {
+			return ""
+		}
+		if stringer, ok := value.Interface().(fmt.Stringer); ok && value.Kind() == reflect.Ptr {
+			return stringer.String()
+		}
+		value = value.Elem()
+	}
+
+	switch v := value.Interface().(type) {
+	case time.Time:
+		return v.Format(time.RFC3339)
+	case fmt.Stringer:
+		return v.String()
+	case []byte:
+		return string(v)
+	}
+	if value.Kind() == reflect.Struct {
+		return fmt.Sprintf("%+v", value.Interface())
+	}
+	return fmt.Sprint(value.Interface())
+}
+
 // Version
 // The version function returns a string representing the version information of the gotable.
 // e.g.
@@ -106,7 +290,11 @@ func Version() string {
 
 func Versions() []string { return constant.GetVersions() }
 
-func ReadFromCSVFile(path string) (*table.Table, error) {
+// ReadFromCSVFile reads a CSV file whose first line holds the columns. A row with fewer fields than the columns is
+// filled with the column defaults. A row with more fields returns an *exception.RowLengthNotEqualColumnsError
+// holding its line number, unless the TruncateLongRows option is given. A repeated column of the header is an error,
+// unless the RenameDuplicateColumns option is given.
+func ReadFromCSVFile(path string, options ...CSVOption) (*table.Table, error) {
 	if !util.IsFile(path) {
 		return nil, exception.FileDoNotExist(path)
 	}
@@ -118,25 +306,48 @@ func ReadFromCSVFile(path string) (*table.Table, error) {
 	if err != nil {
 		return nil, err
 	}
-	reader := csv.NewReader(file)
-	lines, err := reader.ReadAll()
-	if err != nil{
+	defer file.Close()
+
+	tb, err := ReadCSV(file, options...)
+	if err == errEmptyCSV {
+		return nil, fmt.Errorf("csv file %s is empty", path)
+	}
+	return tb, err
+}
+
+// ReadCSV reads a table from CSV data whose first line holds the columns, e.g. a network response or a gzip
+// stream, as ReadFromCSVFile does for a file. It returns an error if the data is empty.
+func ReadCSV(r io.Reader, options ...CSVOption) (*table.Table, error) {
+	o := newCSVOptions(options)
+	lines, err := util.ReadCSV(r)
+	if err != nil {
 		return nil, err
 	}
 	if len(lines) < 1 {
-		return nil, fmt.Errorf("csv file %s is empty", path)
+		return nil, errEmptyCSV
 	}
 
-	tb, err := Create(lines[0]...)
+	columns := lines[0]
+	if o.renameDuplicates {
+		columns = o.uniqueColumns(columns)
+	}
+	tb, err := Create(columns...)
 	if err != nil {
 		return nil, err
 	}
 
 	rows := make([]map[string]string, 0)
-	for _, line := range lines[1:] {
+	for index, line := range lines[1:] {
+		if len(line) > len(columns) {
+			if !o.truncateLongRows {
+				return nil, exception.RowLengthNotEqualColumnsAtLine(index+2, len(line), len(columns))
+			}
+			line = line[:len(columns)]
+		}
+
 		row := make(map[string]string)
 		for i := range line {
-			row[lines[0][i]] = line[i]
+			row[columns[i]] = line[i]
 		}
 		rows = append(rows, row)
 	}
@@ -144,6 +355,69 @@ func ReadFromCSVFile(path string) (*table.Table, error) {
 	return tb, nil
 }
 
+// ReadFromCSVFileStream reads a CSV file whose first line holds the columns one row at a time, and calls fn for each
+// row with its values keyed by column, so that a large file can be processed without loading it into a table. The
+// missing fields of a short row are empty. It stops at the first error returned by fn and returns it. The options
+// are the same as for ReadFromCSVFile.
+func ReadFromCSVFileStream(path string, fn func(row map[string]string) error, options ...CSVOption) error {
+	if !util.IsFile(path) {
+		return exception.FileDoNotExist(path)
+	}
+	if !util.IsCSVFile(path) {
+		return exception.NotARegularCSVFile(path)
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	o := newCSVOptions(options)
+	reader := util.NewCSVReader(file)
+	columns, err := reader.Read()
+	if err == io.EOF {
+		return fmt.Errorf("csv file %s is empty", path)
+	}
+	if err != nil {
+		return err
+	}
+	if o.renameDuplicates {
+		columns = o.uniqueColumns(columns)
+	}
+	// the columns are validated as for a table
+	if _, err := Create(columns...); err != nil {
+		return err
+	}
+
+	for number := 2; ; number++ {
+		line, err := reader.Read()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if len(line) > len(columns) {
+			if !o.truncateLongRows {
+				return exception.RowLengthNotEqualColumnsAtLine(number, len(line), len(columns))
+			}
+			line = line[:len(columns)]
+		}
+
+		row := make(map[string]string)
+		for i, column := range columns {
+			row[column] = ""
+			if i < len(line) {
+				row[column] = line[i]
+			}
+		}
+		if err := fn(row); err != nil {
+			return err
+		}
+	}
+}
+
 func ReadFromJSONFile(path string) (*table.Table, error) {
 	if !util.IsFile(path) {
 		return nil, exception.FileDoNotExist(path)
@@ -158,22 +432,65 @@ func ReadFromJSONFile(path string) (*table.Table, error) {
 	}
 	defer file.Close()
 
-	byteValue, err := ioutil.ReadAll(file)
+	objects, err := decodeJSON(file)
+	if err != nil {
+		return nil, exception.NotGotableJSONFormat(path)
+	}
+	return fromJSONObjects(objects)
+}
+
+// ReadJSON reads a table from a JSON array of objects, as ReadFromJSONFile does for a file. It returns the decoding
+// error if r does not hold an array of objects.
+func ReadJSON(r io.Reader) (*table.Table, error) {
+	objects, err := decodeJSON(r)
 	if err != nil {
 		return nil, err
 	}
+	return fromJSONObjects(objects)
+}
 
-	rows := make([]map[string]string, 0)
-	err = json.Unmarshal(byteValue, &rows)
+// ReadJSONBytes reads a table from a JSON array of objects held in b, see ReadJSON.
+func ReadJSONBytes(b []byte) (*table.Table, error) {
+	return ReadJSON(bytes.NewReader(b))
+}
+
+// decodeJSON decodes an array of objects, keeping the numbers as json.Number.
+func decodeJSON(r io.Reader) ([]map[string]interface{}, error) {
+	objects := make([]map[string]interface{}, 0)
+	decoder := json.NewDecoder(r)
+	decoder.UseNumber()
+	err := decoder.Decode(&objects)
 	if err != nil {
-		return nil, exception.NotGotableJSONFormat(path)
+		return nil, err
+	}
+	return objects, nil
+}
+
+// fromJSONObjects creates a table from decoded JSON objects.
+func fromJSONObjects(objects []map[string]interface{}) (*table.Table, error) {
+	rows := make([]map[string]string, 0)
+	for _, object := range objects {
+		row := make(map[string]string)
+		for key, value := range object {
+			row[key] = jsonString(value)
+		}
+		rows = append(rows, row)
 	}
 
 	if len(rows) == 0 { return Create() }
+
+	// JSON objects are unordered, so the columns are the keys of all the objects sorted by name.
 	columns := make([]string, 0)
-	for column := range rows[0] {
-		columns = append(columns, column)
+	seen := make(map[string]bool)
+	for _, row := range rows {
+		for column := range row {
+			if !seen[column] {
+				seen[column] = true
+				columns = append(columns, column)
+			}
+		}
 	}
+	sort.Strings(columns)
 	tb, err := Create(columns...)
 	if err != nil {
 		return nil, err
@@ -181,3 +498,111 @@ func ReadFromJSONFile(path string) (*table.Table, error) {
 	tb.AddRows(rows)
 	return tb, nil
 }
+
+// ReadFromXLSXFile reads the first sheet of an Excel workbook. The first row of the sheet is used as the columns and
+// the remaining rows as data. Empty cells at the end of a row are filled with the column default.
+func ReadFromXLSXFile(path string) (*table.Table, error) {
+	if !util.IsFile(path) {
+		return nil, exception.FileDoNotExist(path)
+	}
+	if !util.IsXLSXFile(path) {
+		return nil, exception.NotARegularXLSXFile(path)
+	}
+
+	lines, err := xlsx.Read(path)
+	if err != nil {
+		return nil, err
+	}
+	if len(lines) < 1 {
+		return nil, fmt.Errorf("xlsx file %s is empty", path)
+	}
+
+	tb, err := Create(lines[0]...)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([]map[string]string, 0)
+	for _, line := range lines[1:] {
+		row := make(map[string]string)
+		for i := range line {
+			if i >= len(lines[0]) {
+				break
+			}
+			row[lines[0][i]] = line[i]
+		}
+		rows = append(rows, row)
+	}
+	tb.AddRows(rows)
+	return tb, nil
+}
+
+// ReadFromSQLRows creates a table from the result of a query. The columns are read from rows.Columns() and every
+// value is converted to a string with fmt.Sprint, NULL values are set to the column default. The rows are not closed,
+// the caller owns them.
+func ReadFromSQLRows(rows *sql.Rows) (*table.Table, error) {
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+
+	tb, err := Create(columns...)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make([]interface{}, len(columns))
+	pointers := make([]interface{}, len(columns))
+	for i := range values {
+		pointers[i] = &values[i]
+	}
+	for rows.Next() {
+		err = rows.Scan(pointers...)
+		if err != nil {
+			return nil, err
+		}
+
+		row := make(map[string]string)
+		for i, value := range values {
+			switch v := value.(type) {
+			case nil:
+				continue
+			case []byte:
+				row[columns[i]] = string(v)
+			default:
+				row[columns[i]] = fmt.Sprint(v)
+			}
+		}
+		err = tb.AddRow(row)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+	return tb, nil
+}
+
+// jsonString converts a decoded JSON value to the string stored in a table cell. Numbers keep their JSON text, null
+// is an empty string, and objects and arrays are stored as JSON.
+func jsonString(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case json.Number:
+		return v.String()
+	case bool:
+		return strconv.FormatBool(v)
+	default:
+		data, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Sprint(v)
+		}
+		return string(data)
+	}
+}
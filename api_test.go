@@ -0,0 +1,100 @@
+package gotable
+
+import (
+	"errors"
+	"github.com/liushuochen/gotable/exception"
+	"reflect"
+	"testing"
+)
+
+func TestCreateRejectsEmptyColumnNames(t *testing.T) {
+	cases := [][]string{
+		{"", "a"},
+		{"  "},
+		{"a", "\t"},
+	}
+	for _, columns := range cases {
+		_, err := Create(columns...)
+		var target *exception.EmptyColumnNameError
+		if !errors.As(err, &target) {
+			t.Errorf("Create(%q) error = %v, want an *exception.EmptyColumnNameError", columns, err)
+		}
+	}
+
+	if _, err := Create(" a ", "a"); err != nil {
+		t.Errorf("Create(%q, %q) error = %v, want nil as names are not trimmed", " a ", "a", err)
+	}
+}
+
+func TestRenameColumnRejectsEmptyName(t *testing.T) {
+	tb, err := Create("a", "b")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"", "   "} {
+		err := tb.RenameColumn("a", name)
+		var target *exception.EmptyColumnNameError
+		if !errors.As(err, &target) {
+			t.Errorf("RenameColumn(%q, %q) error = %v, want an *exception.EmptyColumnNameError", "a", name, err)
+		}
+	}
+	if columns := tb.GetColumns(); columns[0] != "a" {
+		t.Errorf("columns = %q, want the column a to keep its name", columns)
+	}
+}
+
+func TestCreateByStructSkipsFields(t *testing.T) {
+	type user struct {
+		ID       int
+		Name     string `gotable:"name"`
+		Password string `gotable:"-"`
+		age      int
+	}
+
+	tb, err := CreateByStruct(&user{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if columns, want := tb.GetColumns(), []string{"ID", "name"}; !reflect.DeepEqual(columns, want) {
+		t.Errorf("columns = %q, want %q", columns, want)
+	}
+}
+
+func TestNew(t *testing.T) {
+	tb := New("a", "b").WithAlign("a", Right).WithRow([]string{"1", "2"})
+	if err := tb.Err(); err != nil {
+		t.Fatalf("Err() = %v, want nil", err)
+	}
+	if columns := tb.GetColumns(); !reflect.DeepEqual(columns, []string{"a", "b"}) {
+		t.Errorf("columns = %q", columns)
+	}
+
+	for _, columns := range [][]string{nil, {""}, {"a", "a"}} {
+		tb := New(columns...).WithAlign("a", Right).WithRow([]string{"1"})
+		_, want := Create(columns...)
+		if err := tb.Err(); err == nil || err.Error() != want.Error() {
+			t.Errorf("New(%q).Err() = %v, want %v", columns, err, want)
+		}
+		if tb.ColumnCount() != 0 || tb.Length() != 0 {
+			t.Errorf("New(%q) has %d columns and %d rows, want none", columns, tb.ColumnCount(), tb.Length())
+		}
+	}
+}
+
+func TestMustNew(t *testing.T) {
+	tb := MustNew("a", "b").WithAlign("a", Right)
+	if columns := tb.GetColumns(); !reflect.DeepEqual(columns, []string{"a", "b"}) {
+		t.Errorf("columns = %q", columns)
+	}
+
+	for _, columns := range [][]string{nil, {""}, {"a", "a"}} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("MustNew(%q) did not panic", columns)
+				}
+			}()
+			MustNew(columns...)
+		}()
+	}
+}
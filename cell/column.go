@@ -16,7 +16,24 @@ type Column struct {
 	coloredName		string
 	defaultValue	string
 	align			int
+	headerAlign		int
+	fillChar		string
 	length			int
+	separator		string
+	color			*color.Color
+	group			string
+	formatter		func(string) string
+	validator		func(string) error
+	compute			func(map[string]string) string
+	listSeparator	string
+	rtl				bool
+	colorRules		[]colorRule
+}
+
+// colorRule colors the values of a column that match its predicate.
+type colorRule struct {
+	predicate	func(string) bool
+	color		*color.Color
 }
 
 func CreateColumn(name string) *Column {
@@ -25,11 +42,19 @@ func CreateColumn(name string) *Column {
 		coloredName:  name,
 		defaultValue: "",
 		align:        AlignCenter,
+		headerAlign:  -1,
 		length:       util.Length(name),
 	}
 	return h
 }
 
+// Clone returns a copy of the column with the same name and settings.
+func (h *Column) Clone() *Column {
+	clone := *h
+	clone.colorRules = append([]colorRule(nil), h.colorRules...)
+	return &clone
+}
+
 func (h *Column) String() string {
 	return h.coloredName
 }
@@ -38,6 +63,16 @@ func (h *Column) Original() string {
 	return h.name
 }
 
+// SetName renames the column. A colored column keeps its color.
+func (h *Column) SetName(name string) {
+	h.name = name
+	h.coloredName = name
+	h.length = util.Length(name)
+	if h.color != nil {
+		h.coloredName = h.color.Combine(name)
+	}
+}
+
 func (h *Column) Length() int {
 	return h.length
 }
@@ -50,6 +85,51 @@ func (h *Column) SetDefault(value string) {
 	h.defaultValue = value
 }
 
+// Separator returns the custom separator printed after the column. An empty string means the table default.
+func (h *Column) Separator() string {
+	return h.separator
+}
+
+func (h *Column) SetSeparator(sep string) {
+	h.separator = sep
+}
+
+// Group returns the name of the group the column belongs to. An empty string means the column is not grouped.
+func (h *Column) Group() string {
+	return h.group
+}
+
+func (h *Column) SetGroup(name string) {
+	h.group = name
+}
+
+// Formatter returns the function that formats the values of the column when they are printed, or nil.
+func (h *Column) Formatter() func(string) string {
+	return h.formatter
+}
+
+func (h *Column) SetFormatter(fn func(string) string) {
+	h.formatter = fn
+}
+
+// Validator returns the function that validates the values added to the column, or nil.
+func (h *Column) Validator() func(string) error {
+	return h.validator
+}
+
+func (h *Column) SetValidator(fn func(string) error) {
+	h.validator = fn
+}
+
+// Compute returns the function that computes the value of the column from the other values of a row, or nil.
+func (h *Column) Compute() func(map[string]string) string {
+	return h.compute
+}
+
+func (h *Column) SetCompute(fn func(map[string]string) string) {
+	h.compute = fn
+}
+
 func (h *Column) Align() int {
 	return h.align
 }
@@ -78,6 +158,54 @@ func (h *Column) SetAlign(mode int) {
 	}
 }
 
+// FillChar returns the character the values of the column are padded with when they are aligned, a space by default.
+func (h *Column) FillChar() string {
+	if h.fillChar == "" {
+		return " "
+	}
+	return h.fillChar
+}
+
+func (h *Column) SetFillChar(ch rune) {
+	h.fillChar = string(ch)
+}
+
+// RTL reports whether the values of the column are written right to left.
+func (h *Column) RTL() bool {
+	return h.rtl
+}
+
+func (h *Column) SetRTL(rtl bool) {
+	h.rtl = rtl
+}
+
+// ListSeparator returns the separator the values of the column are split on to be printed as a list, or "".
+func (h *Column) ListSeparator() string {
+	return h.listSeparator
+}
+
+func (h *Column) SetListSeparator(sep string) {
+	h.listSeparator = sep
+}
+
+// HeaderAlign returns the alignment of the column name in the header, which is the alignment of the column unless
+// it was set by SetHeaderAlign.
+func (h *Column) HeaderAlign() int {
+	if h.headerAlign < 0 {
+		return h.align
+	}
+	return h.headerAlign
+}
+
+func (h *Column) SetHeaderAlign(mode int) {
+	switch mode {
+	case AlignLeft, AlignRight:
+		h.headerAlign = mode
+	default:
+		h.headerAlign = AlignCenter
+	}
+}
+
 func (h *Column) Equal(other *Column) bool {
 	functions := []func(o *Column) bool {
 		h.nameEqual,
@@ -100,9 +228,31 @@ func (h *Column) SetColor(displayType, font, background int) {
 	c.Font = font
 	c.Background = background
 	h.coloredName = c.Combine(h.Original())
+	h.color = c
 	return
 }
 
+// AddColorRule adds a rule that colors the values of the column that match the predicate.
+func (h *Column) AddColorRule(predicate func(string) bool, displayType, font, background int) {
+	c := &color.Color{Display: displayType, Font: font, Background: background}
+	h.colorRules = append(h.colorRules, colorRule{predicate, c})
+}
+
+// RuleColor returns the color of the first color rule the value matches, or nil if it matches none.
+func (h *Column) RuleColor(value string) *color.Color {
+	for _, rule := range h.colorRules {
+		if rule.predicate(value) {
+			return rule.color
+		}
+	}
+	return nil
+}
+
+// Color returns the color set by SetColor, or nil if the column is not colored.
+func (h *Column) Color() *color.Color {
+	return h.color
+}
+
 func (h *Column) Colorful() bool {
 	return h.String() != h.Original()
 }
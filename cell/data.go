@@ -7,12 +7,14 @@ import (
 type Data struct {
 	value	string
 	length	int
+	align	int
 }
 
 func CreateData(value string) *Data {
 	d := new(Data)
 	d.value = value
 	d.length = util.Length(value)
+	d.align = -1
 	return d
 }
 
@@ -31,3 +33,12 @@ func (d *Data) Length() int {
 func (d *Data) Original() string {
 	return d.String()
 }
+
+// Align returns the alignment of the cell, or -1 if the cell is aligned like its column.
+func (d *Data) Align() int {
+	return d.align
+}
+
+func (d *Data) SetAlign(mode int) {
+	d.align = mode
+}
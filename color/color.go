@@ -1,6 +1,10 @@
 package color
 
-import "fmt"
+import (
+	"fmt"
+	"image/color"
+	"strings"
+)
 
 type Color struct {
 	Display		int
@@ -18,3 +22,58 @@ func (c *Color) Combine(message string) string {
 	}
 	return value
 }
+
+var cssColors = map[int]string{
+	30: "black",
+	31: "red",
+	32: "green",
+	33: "yellow",
+	34: "blue",
+	35: "purple",
+	36: "cyan",
+	37: "white",
+}
+
+// Style converts the color into an inline CSS declaration list, e.g. "font-weight:bold;color:red".
+func (c *Color) Style() string {
+	declarations := make([]string, 0)
+	switch c.Display {
+	case 1:
+		declarations = append(declarations, "font-weight:bold")
+	case 4:
+		declarations = append(declarations, "text-decoration:underline")
+	case 5:
+		declarations = append(declarations, "text-decoration:blink")
+	}
+	if name, ok := cssColors[c.Font]; ok {
+		declarations = append(declarations, "color:"+name)
+	}
+	if name, ok := cssColors[c.Background-10]; ok {
+		declarations = append(declarations, "background-color:"+name)
+	}
+	return strings.Join(declarations, ";")
+}
+
+var rgbaColors = map[int]color.RGBA{
+	30: {0, 0, 0, 255},
+	31: {205, 49, 49, 255},
+	32: {13, 188, 121, 255},
+	33: {229, 229, 16, 255},
+	34: {36, 114, 200, 255},
+	35: {188, 63, 188, 255},
+	36: {17, 168, 205, 255},
+	37: {229, 229, 229, 255},
+}
+
+// FontRGBA returns the font color as an RGBA value. The second return value is false if no font color is set.
+func (c *Color) FontRGBA() (color.RGBA, bool) {
+	value, ok := rgbaColors[c.Font]
+	return value, ok
+}
+
+// BackgroundRGBA returns the background color as an RGBA value. The second return value is false if no background
+// color is set.
+func (c *Color) BackgroundRGBA() (color.RGBA, bool) {
+	value, ok := rgbaColors[c.Background-10]
+	return value, ok
+}
@@ -0,0 +1,73 @@
+package gotable
+
+import (
+	"errors"
+	"fmt"
+)
+
+var errEmptyCSV = errors.New("csv data is empty")
+
+// CSVOption configures how a CSV file is read.
+type CSVOption func(*csvOptions)
+
+type csvOptions struct {
+	truncateLongRows bool
+	renameDuplicates bool
+	renamed          map[string]string
+}
+
+// TruncateLongRows drops the extra fields of the rows that have more fields than the header. By default such a row
+// is an error.
+func TruncateLongRows() CSVOption {
+	return func(options *csvOptions) {
+		options.truncateLongRows = true
+	}
+}
+
+// RenameDuplicateColumns renames the repeated columns of the header instead of failing: the second "name" column
+// becomes "name_2", the third "name_3" and so on, skipping the names already in the header. When renamed is not nil,
+// it is filled with the new names as keys and the original names as values. By default a repeated column is an
+// error.
+func RenameDuplicateColumns(renamed map[string]string) CSVOption {
+	return func(options *csvOptions) {
+		options.renameDuplicates = true
+		options.renamed = renamed
+	}
+}
+
+// uniqueColumns returns the header with its repeated columns renamed.
+func (o *csvOptions) uniqueColumns(header []string) []string {
+	used := make(map[string]bool)
+	for _, column := range header {
+		used[column] = true
+	}
+
+	columns := make([]string, 0)
+	seen := make(map[string]bool)
+	for _, column := range header {
+		if !seen[column] {
+			seen[column] = true
+			columns = append(columns, column)
+			continue
+		}
+
+		name := column
+		for n := 2; used[name]; n++ {
+			name = fmt.Sprintf("%s_%d", column, n)
+		}
+		used[name] = true
+		columns = append(columns, name)
+		if o.renamed != nil {
+			o.renamed[name] = column
+		}
+	}
+	return columns
+}
+
+func newCSVOptions(options []CSVOption) *csvOptions {
+	o := new(csvOptions)
+	for _, option := range options {
+		option(o)
+	}
+	return o
+}
@@ -0,0 +1,39 @@
+package gotable
+
+import (
+	"errors"
+	"github.com/liushuochen/gotable/exception"
+	"reflect"
+	"testing"
+)
+
+func TestReadFromCSVFileRaggedRows(t *testing.T) {
+	_, err := ReadFromCSVFile("testdata/ragged.csv")
+	var target *exception.RowLengthNotEqualColumnsError
+	if !errors.As(err, &target) {
+		t.Fatalf("error = %v, want an *exception.RowLengthNotEqualColumnsError", err)
+	}
+	if target.Line() != 4 {
+		t.Errorf("Line() = %d, want 4", target.Line())
+	}
+}
+
+func TestReadFromCSVFileTruncateLongRows(t *testing.T) {
+	tb, err := ReadFromCSVFile("testdata/ragged.csv", TruncateLongRows())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if columns := tb.GetColumns(); !reflect.DeepEqual(columns, []string{"id", "name", "city"}) {
+		t.Errorf("columns = %q", columns)
+	}
+	want := []map[string]string{
+		{"id": "1", "name": "Alice", "city": "Paris"},
+		{"id": "2", "name": "Bob", "city": ""},
+		{"id": "3", "name": "Carol", "city": "Rome"},
+		{"id": "4", "name": "", "city": ""},
+	}
+	if got := tb.GetValues(); !reflect.DeepEqual(got, want) {
+		t.Errorf("values:\ngot  %v\nwant %v", got, want)
+	}
+}
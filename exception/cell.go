@@ -0,0 +1,29 @@
+package exception
+
+import "fmt"
+
+type InvalidCellValueError struct {
+	*baseError
+	column	string
+	value	string
+	cause	error
+}
+
+func InvalidCellValue(column, value string, cause error) *InvalidCellValueError {
+	message := fmt.Sprintf("invalid value %q for column %s: %s", value, column, cause.Error())
+	err := &InvalidCellValueError{createBaseError(message), column, value, cause}
+	return err
+}
+
+func (e *InvalidCellValueError) Column() string {
+	return e.column
+}
+
+func (e *InvalidCellValueError) Value() string {
+	return e.value
+}
+
+// Unwrap returns the error returned by the column validator.
+func (e *InvalidCellValueError) Unwrap() error {
+	return e.cause
+}
@@ -26,3 +26,60 @@ func ColumnDoNotExist(name string) *ColumnDoNotExistError {
 	err := &ColumnDoNotExistError{createBaseError(message), name}
 	return err
 }
+
+
+type ColumnsMismatchError struct {
+	*baseError
+	missing	[]string
+	extra	[]string
+}
+
+// Missing returns the columns of the table that were not given.
+func (e *ColumnsMismatchError) Missing() []string {
+	return e.missing
+}
+
+// Extra returns the given columns that do not exist in the table, or that were given more than once.
+func (e *ColumnsMismatchError) Extra() []string {
+	return e.extra
+}
+
+func ColumnsMismatch(missing, extra []string) *ColumnsMismatchError {
+	message := fmt.Sprintf("columns mismatch: missing %v, extra %v", missing, extra)
+	err := &ColumnsMismatchError{createBaseError(message), missing, extra}
+	return err
+}
+
+
+type EmptyColumnNameError struct {
+	*baseError
+	name	string
+}
+
+// Name returns the rejected column name, which is empty or only holds whitespace.
+func (e *EmptyColumnNameError) Name() string {
+	return e.name
+}
+
+func EmptyColumnName(name string) *EmptyColumnNameError {
+	message := fmt.Sprintf("column name %q is empty", name)
+	err := &EmptyColumnNameError{createBaseError(message), name}
+	return err
+}
+
+
+type ColumnsNotAdjacentError struct {
+	*baseError
+	columns	[]string
+}
+
+// Columns returns the given columns, which are not a run of adjacent columns of the table.
+func (e *ColumnsNotAdjacentError) Columns() []string {
+	return e.columns
+}
+
+func ColumnsNotAdjacent(columns []string) *ColumnsNotAdjacentError {
+	message := fmt.Sprintf("columns %v are not adjacent", columns)
+	err := &ColumnsNotAdjacentError{createBaseError(message), columns}
+	return err
+}
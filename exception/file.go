@@ -59,3 +59,81 @@ func NotGotableJSONFormat(path string) *NotGotableJSONFormatError {
 	err := &NotGotableJSONFormatError{createFileError(path, message)}
 	return err
 }
+
+
+type NotARegularXLSXFileError struct {
+	*fileError
+}
+
+func NotARegularXLSXFile(path string) *NotARegularXLSXFileError {
+	message := fmt.Sprintf("not a regular xlsx file: %s", path)
+	err := &NotARegularXLSXFileError{createFileError(path, message)}
+	return err
+}
+
+
+type NotARegularPNGFileError struct {
+	*fileError
+}
+
+func NotARegularPNGFile(path string) *NotARegularPNGFileError {
+	message := fmt.Sprintf("not a regular png file: %s", path)
+	err := &NotARegularPNGFileError{createFileError(path, message)}
+	return err
+}
+
+
+type NotARegularJSONLFileError struct {
+	*fileError
+}
+
+func NotARegularJSONLFile(path string) *NotARegularJSONLFileError {
+	message := fmt.Sprintf("not a regular jsonl file: %s", path)
+	err := &NotARegularJSONLFileError{createFileError(path, message)}
+	return err
+}
+
+
+type NotARegularYAMLFileError struct {
+	*fileError
+}
+
+func NotARegularYAMLFile(path string) *NotARegularYAMLFileError {
+	message := fmt.Sprintf("not a regular yaml file: %s", path)
+	err := &NotARegularYAMLFileError{createFileError(path, message)}
+	return err
+}
+
+
+type NotARegularTOMLFileError struct {
+	*fileError
+}
+
+func NotARegularTOMLFile(path string) *NotARegularTOMLFileError {
+	message := fmt.Sprintf("not a regular toml file: %s", path)
+	err := &NotARegularTOMLFileError{createFileError(path, message)}
+	return err
+}
+
+
+type CSVHeaderMismatchError struct {
+	*fileError
+	header	[]string
+	columns	[]string
+}
+
+// Header returns the header read from the CSV file.
+func (e *CSVHeaderMismatchError) Header() []string {
+	return e.header
+}
+
+// Columns returns the columns of the table, in order.
+func (e *CSVHeaderMismatchError) Columns() []string {
+	return e.columns
+}
+
+func CSVHeaderMismatch(path string, header, columns []string) *CSVHeaderMismatchError {
+	message := fmt.Sprintf("csv file %s: header %v does not match columns %v", path, header, columns)
+	err := &CSVHeaderMismatchError{createFileError(path, message), header, columns}
+	return err
+}
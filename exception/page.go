@@ -0,0 +1,23 @@
+package exception
+
+import "fmt"
+
+type PageOutOfRangeError struct {
+	*baseError
+	page		int
+	pageCount	int
+}
+
+func PageOutOfRange(page, pageCount int) *PageOutOfRangeError {
+	message := fmt.Sprintf("page %d out of range, the table has %d page(s)", page, pageCount)
+	err := &PageOutOfRangeError{createBaseError(message), page, pageCount}
+	return err
+}
+
+func (e *PageOutOfRangeError) Page() int {
+	return e.page
+}
+
+func (e *PageOutOfRangeError) PageCount() int {
+	return e.pageCount
+}
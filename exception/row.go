@@ -26,6 +26,7 @@ type RowLengthNotEqualColumnsError struct {
 	*baseError
 	rowLength		int
 	columnLength	int
+	line			int
 }
 
 func RowLengthNotEqualColumns(rowLength, columnLength int) *RowLengthNotEqualColumnsError {
@@ -37,3 +38,63 @@ func RowLengthNotEqualColumns(rowLength, columnLength int) *RowLengthNotEqualCol
 	}
 	return err
 }
+
+// RowLengthNotEqualColumnsAtLine returns a *RowLengthNotEqualColumnsError for the row read at the given line of a
+// file.
+func RowLengthNotEqualColumnsAtLine(line, rowLength, columnLength int) *RowLengthNotEqualColumnsError {
+	err := RowLengthNotEqualColumns(rowLength, columnLength)
+	err.message = fmt.Sprintf("line %d: %s", line, err.message)
+	err.line = line
+	return err
+}
+
+// Line returns the line of the file the row was read at, or 0 if the row was not read from a file.
+func (e *RowLengthNotEqualColumnsError) Line() int {
+	return e.line
+}
+
+
+type RowIndexOutOfRangeError struct {
+	*baseError
+	index	int
+	length	int
+}
+
+func RowIndexOutOfRange(index, length int) *RowIndexOutOfRangeError {
+	message := fmt.Sprintf("row index %d out of range [0, %d]", index, length)
+	err := &RowIndexOutOfRangeError{createBaseError(message), index, length}
+	return err
+}
+
+// Index returns the requested row index.
+func (e *RowIndexOutOfRangeError) Index() int {
+	return e.index
+}
+
+// Length returns the number of rows of the table.
+func (e *RowIndexOutOfRangeError) Length() int {
+	return e.length
+}
+
+
+type InvalidRowError struct {
+	*baseError
+	index	int
+	cause	error
+}
+
+func InvalidRow(index int, cause error) *InvalidRowError {
+	message := fmt.Sprintf("row %d: %s", index, cause.Error())
+	err := &InvalidRowError{createBaseError(message), index, cause}
+	return err
+}
+
+// Index returns the index of the rejected row in the given rows.
+func (e *InvalidRowError) Index() int {
+	return e.index
+}
+
+// Unwrap returns the error the row was rejected with.
+func (e *InvalidRowError) Unwrap() error {
+	return e.cause
+}
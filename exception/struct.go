@@ -0,0 +1,61 @@
+package exception
+
+import "fmt"
+
+type NotAStructPointerError struct {
+	*baseError
+	t	string
+}
+
+func NotAStructPointer(v interface{}) *NotAStructPointerError {
+	t := fmt.Sprintf("%T", v)
+	message := fmt.Sprintf("%s is not a pointer to struct", t)
+	err := &NotAStructPointerError{createBaseError(message), t}
+	return err
+}
+
+// Type returns the type of the given value.
+func (e *NotAStructPointerError) Type() string {
+	return e.t
+}
+
+
+type DuplicateStructColumnError struct {
+	*baseError
+	column	string
+	fields	[]string
+}
+
+func DuplicateStructColumn(column string, fields []string) *DuplicateStructColumnError {
+	message := fmt.Sprintf("fields %v are all mapped to column %s", fields, column)
+	err := &DuplicateStructColumnError{createBaseError(message), column, fields}
+	return err
+}
+
+// Column returns the duplicated column.
+func (e *DuplicateStructColumnError) Column() string {
+	return e.column
+}
+
+// Fields returns the names of the struct fields mapped to the column.
+func (e *DuplicateStructColumnError) Fields() []string {
+	return e.fields
+}
+
+
+type NotAStructSliceError struct {
+	*baseError
+	t	string
+}
+
+func NotAStructSlice(v interface{}) *NotAStructSliceError {
+	t := fmt.Sprintf("%T", v)
+	message := fmt.Sprintf("%s is not a slice of struct or of pointer to struct", t)
+	err := &NotAStructSliceError{createBaseError(message), t}
+	return err
+}
+
+// Type returns the type of the given value.
+func (e *NotAStructSliceError) Type() string {
+	return e.t
+}
@@ -1,3 +1,8 @@
 module github.com/liushuochen/gotable
 
 go 1.14
+
+require (
+	golang.org/x/image v0.0.0-20201208152932-35266b937fa6
+	golang.org/x/term v0.0.0-20201210144234-2321bbc49cbf
+)
@@ -0,0 +1,117 @@
+package gotable
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"github.com/liushuochen/gotable/exception"
+	"github.com/liushuochen/gotable/table"
+	"io"
+	"regexp"
+	"strings"
+)
+
+var markdownDelimiterPattern = regexp.MustCompile(`^:?-+:?$`)
+
+var markdownUnescaper = strings.NewReplacer(
+	`\\`, `\`,
+	`\|`, `|`,
+	"<br>", "\n",
+)
+
+// ReadFromMarkdown reads a GitHub Flavored Markdown table, such as the one written by the Markdown method: the header
+// row, the delimiter row, whose colons set the alignment of the columns, and the rows. Blank lines are skipped,
+// "\\" and "\|" are unescaped and <br> becomes a line break.
+// Return error types:
+//   - *exception.RowLengthNotEqualColumnsError: It returned if a row does not have as many cells as the header, with
+//       its line number.
+//   - An error if the data is empty or the delimiter row is invalid.
+func ReadFromMarkdown(r io.Reader) (*table.Table, error) {
+	scanner := bufio.NewScanner(r)
+	number := 0
+	var tb *table.Table
+	var columns []string
+	delimited := false
+	for scanner.Scan() {
+		number++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+
+		cells := splitMarkdownRow(line)
+		for index := range cells {
+			cells[index] = markdownUnescaper.Replace(cells[index])
+		}
+		switch {
+		case columns == nil:
+			columns = cells
+			var err error
+			tb, err = Create(columns...)
+			if err != nil {
+				return nil, err
+			}
+		case len(cells) != len(columns):
+			return nil, exception.RowLengthNotEqualColumnsAtLine(number, len(cells), len(columns))
+		case !delimited:
+			for index, c := range cells {
+				if !markdownDelimiterPattern.MatchString(c) {
+					return nil, fmt.Errorf("line %d: invalid markdown delimiter %q", number, c)
+				}
+				tb.Align(columns[index], markdownAlign(c))
+			}
+			delimited = true
+		default:
+			err := tb.AddRow(cells)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if !delimited {
+		return nil, errors.New("markdown table has no header and delimiter rows")
+	}
+	return tb, nil
+}
+
+// splitMarkdownRow returns the trimmed cells of a Markdown table row. The pipes at the edges are optional and
+// escaped pipes do not split cells.
+func splitMarkdownRow(line string) []string {
+	line = strings.TrimPrefix(line, "|")
+	if strings.HasSuffix(line, "|") {
+		// the last pipe is escaped if it follows an odd number of backslashes
+		backslashes := len(line) - 1 - len(strings.TrimRight(line[:len(line)-1], `\`))
+		if backslashes%2 == 0 {
+			line = line[:len(line)-1]
+		}
+	}
+
+	cells := make([]string, 0)
+	start := 0
+	for index := 0; index < len(line); index++ {
+		switch line[index] {
+		case '\\':
+			index++
+		case '|':
+			cells = append(cells, strings.TrimSpace(line[start:index]))
+			start = index + 1
+		}
+	}
+	return append(cells, strings.TrimSpace(line[start:]))
+}
+
+func markdownAlign(delimiter string) int {
+	left := strings.HasPrefix(delimiter, ":")
+	right := strings.HasSuffix(delimiter, ":")
+	switch {
+	case left && !right:
+		return Left
+	case right && !left:
+		return Right
+	default:
+		return Center
+	}
+}
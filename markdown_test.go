@@ -0,0 +1,58 @@
+package gotable
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestMarkdownRoundTrip(t *testing.T) {
+	tb, err := Create("id", "value")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tb.Align("id", Right)
+	tb.Align("value", Left)
+	values := []string{`x\|y`, `a|b`, `back\slash`, `trailing\`, `\\`, "two\nlines", ""}
+	for index, value := range values {
+		if err := tb.AddRow([]string{string(rune('1' + index)), value}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	content, err := tb.Markdown()
+	if err != nil {
+		t.Fatal(err)
+	}
+	read, err := ReadFromMarkdown(strings.NewReader(content))
+	if err != nil {
+		t.Fatalf("%v\n%s", err, content)
+	}
+	if got, want := read.GetValues(), tb.GetValues(); !reflect.DeepEqual(got, want) {
+		t.Errorf("got  %q\nwant %q\n%s", got, want, content)
+	}
+
+	again, err := read.Markdown()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if again != content {
+		t.Errorf("the alignment is not kept:\ngot\n%s\nwant\n%s", again, content)
+	}
+}
+
+func TestSplitMarkdownRow(t *testing.T) {
+	cases := map[string][]string{
+		`| a | b |`:     {"a", "b"},
+		`a | b`:         {"a", "b"},
+		`| a\|b | c |`:  {`a\|b`, "c"},
+		`| a | b\\|`:    {"a", `b\\`},
+		`| a | b\|`:     {"a", `b\|`},
+		`| a\\\| | b |`: {`a\\\|`, "b"},
+	}
+	for line, want := range cases {
+		if got := splitMarkdownRow(line); !reflect.DeepEqual(got, want) {
+			t.Errorf("splitMarkdownRow(%q) = %q, want %q", line, got, want)
+		}
+	}
+}
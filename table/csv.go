@@ -0,0 +1,84 @@
+package table
+
+import (
+	"encoding/csv"
+	"io"
+	"strings"
+)
+
+// CSVWriteOption configures how the table is written as CSV.
+type CSVWriteOption func(*csvWriteOptions)
+
+type csvWriteOptions struct {
+	alwaysQuote bool
+	useCRLF     bool
+}
+
+// AlwaysQuote quotes every field. By default a field is only quoted when it contains a comma, a quote, a line break
+// or leading space.
+func AlwaysQuote() CSVWriteOption {
+	return func(options *csvWriteOptions) {
+		options.alwaysQuote = true
+	}
+}
+
+// UseCRLF ends the lines with \r\n instead of \n, including the line breaks inside the fields.
+func UseCRLF() CSVWriteOption {
+	return func(options *csvWriteOptions) {
+		options.useCRLF = true
+	}
+}
+
+func newCSVWriteOptions(options []CSVWriteOption) *csvWriteOptions {
+	o := new(csvWriteOptions)
+	for _, option := range options {
+		option(o)
+	}
+	return o
+}
+
+// CSV returns the columns and the rows of the table as CSV, with the same content ToCSVFile saves. The options are
+// the same as for ToCSVFile.
+func (tb *Table) CSV(options ...CSVWriteOption) (string, error) {
+	tb.rLock()
+	defer tb.rUnlock()
+	builder := new(strings.Builder)
+	err := tb.writeCSV(builder, newCSVWriteOptions(options))
+	if err != nil {
+		return "", err
+	}
+	return builder.String(), nil
+}
+
+// writeCSV writes the columns and the rows of the table to w as CSV.
+func (tb *Table) writeCSV(w io.Writer, o *csvWriteOptions) error {
+	if !o.alwaysQuote {
+		writer := csv.NewWriter(w)
+		writer.UseCRLF = o.useCRLF
+		err := writer.WriteAll(tb.records())
+		if err != nil {
+			return err
+		}
+		return writer.Error()
+	}
+
+	end := "\n"
+	if o.useCRLF {
+		end = "\r\n"
+	}
+	for _, record := range tb.records() {
+		fields := make([]string, 0)
+		for _, field := range record {
+			if o.useCRLF {
+				// line breaks are converted as csv.Writer does
+				field = strings.ReplaceAll(strings.ReplaceAll(field, "\r", ""), "\n", "\r\n")
+			}
+			fields = append(fields, `"`+strings.ReplaceAll(field, `"`, `""`)+`"`)
+		}
+		_, err := io.WriteString(w, strings.Join(fields, ",")+end)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
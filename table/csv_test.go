@@ -0,0 +1,27 @@
+package table
+
+import "testing"
+
+func TestCSVLineBreaks(t *testing.T) {
+	tb := newTable(t, []string{"id", "note"}, []string{"1", "a\nb"}, []string{"2", "c\r\nd"})
+	cases := []struct {
+		name    string
+		options []CSVWriteOption
+		want    string
+	}{
+		{"default", nil, "id,note\n1,\"a\nb\"\n2,\"c\r\nd\"\n"},
+		{"UseCRLF", []CSVWriteOption{UseCRLF()}, "id,note\r\n1,\"a\r\nb\"\r\n2,\"c\r\nd\"\r\n"},
+		{"AlwaysQuote", []CSVWriteOption{AlwaysQuote()}, "\"id\",\"note\"\n\"1\",\"a\nb\"\n\"2\",\"c\r\nd\"\n"},
+		{"AlwaysQuote and UseCRLF", []CSVWriteOption{AlwaysQuote(), UseCRLF()},
+			"\"id\",\"note\"\r\n\"1\",\"a\r\nb\"\r\n\"2\",\"c\r\nd\"\r\n"},
+	}
+	for _, c := range cases {
+		got, err := tb.CSV(c.options...)
+		if err != nil {
+			t.Fatalf("%s: %v", c.name, err)
+		}
+		if got != c.want {
+			t.Errorf("%s:\ngot  %q\nwant %q", c.name, got, c.want)
+		}
+	}
+}
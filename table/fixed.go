@@ -0,0 +1,62 @@
+package table
+
+import (
+	"github.com/liushuochen/gotable/cell"
+	"github.com/liushuochen/gotable/util"
+	"strings"
+)
+
+var fixedWidthEscaper = strings.NewReplacer(
+	"\r\n", " ",
+	"\n", " ",
+)
+
+// FixedWidth returns the table as fixed-width fields for legacy parsers: one line per row, header included, with
+// every value padded with spaces to the width of its column and no border or separator at all. The values are
+// formatted, measured and aligned like in PrintTable, so the columns have the widths PrintTable gives them, and line
+// breaks become spaces, which widens the column when a value spans several lines.
+func (tb *Table) FixedWidth() (string, error) {
+	tb.rLock()
+	defer tb.rUnlock()
+	columns := tb.Columns.base
+	cells := [][]cell.Cell{tb.header(columns)}
+	for _, row := range tb.Row {
+		cells = append(cells, tb.cells(row))
+	}
+	widths := tb.layout(columns, cells).widths
+
+	rows := make([][]string, 0)
+	for _, row := range cells {
+		values := make([]string, 0)
+		for index, c := range row {
+			value := fixedWidthEscaper.Replace(c.String())
+			widths[index] = max(widths[index], util.Length(value))
+			values = append(values, value)
+		}
+		rows = append(rows, values)
+	}
+
+	builder := new(strings.Builder)
+	for i, row := range rows {
+		for index, col := range columns {
+			align := col.Align()
+			if i == 0 {
+				align = col.HeaderAlign()
+			}
+
+			value := ""
+			c := cell.CreateData(row[index])
+			switch align {
+			case R:
+				value, _ = right(c, widths[index], " ")
+			case L:
+				value, _ = left(c, widths[index], " ")
+			default:
+				value, _ = center(c, widths[index], " ", tb.leftBias)
+			}
+			builder.WriteString(value)
+		}
+		builder.WriteString("\n")
+	}
+	return builder.String(), nil
+}
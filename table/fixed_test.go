@@ -0,0 +1,52 @@
+package table
+
+import (
+	"github.com/liushuochen/gotable/util"
+	"strings"
+	"testing"
+)
+
+func TestFixedWidth(t *testing.T) {
+	tb := newTable(t, []string{"id", "city", "note"},
+		[]string{"1", "北京", "e\u0301te"},
+		[]string{"22", "Rome", ""},
+	)
+	tb.Align("id", R)
+	tb.Align("city", L)
+	tb.SetNullText("-")
+
+	got, err := tb.FixedWidth()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "idcitynote\n 1北京e\u0301te \n22Rome -  \n"
+	if got != want {
+		t.Errorf("got\n%q\nwant\n%q", got, want)
+	}
+
+	// the columns have the widths PrintTable gives them
+	total := 0
+	for _, column := range tb.GetColumns() {
+		width, err := tb.ColumnWidth(column)
+		if err != nil {
+			t.Fatal(err)
+		}
+		total += width
+	}
+	for _, line := range strings.Split(strings.TrimSuffix(got, "\n"), "\n") {
+		if util.Length(line) != total {
+			t.Errorf("line %q is %d characters wide, want %d", line, util.Length(line), total)
+		}
+	}
+}
+
+func TestFixedWidthLineBreaks(t *testing.T) {
+	tb := newTable(t, []string{"a"}, []string{"x\ny"})
+	got, err := tb.FixedWidth()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := " a \nx y\n"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,94 @@
+package table
+
+import "github.com/liushuochen/gotable/cell"
+
+// The methods of this file wrap the setters of the table and return the table, so that the configuration can be
+// chained:
+//
+//	tb := gotable.New("name", "age").WithAlign("age", gotable.Right).Border(false).WithTitle("Users")
+//
+// Errors returned by the wrapped setters are ignored, e.g. a column that does not exist is silently skipped, except
+// for WithRow, whose first error is kept and returned by Err, as is the error of the columns given to gotable.New.
+// Use the wrapped setters directly when the error matters.
+
+// Border opens or closes the border of the table and returns the table.
+func (tb *Table) Border(enable bool) *Table {
+	if enable {
+		tb.OpenBorder()
+	} else {
+		tb.CloseBorder()
+	}
+	return tb
+}
+
+// WithTitle calls SetTitle and returns the table.
+func (tb *Table) WithTitle(title string) *Table {
+	tb.SetTitle(title)
+	return tb
+}
+
+// WithAlign calls Align and returns the table.
+func (tb *Table) WithAlign(column string, mode int) *Table {
+	tb.Align(column, mode)
+	return tb
+}
+
+// WithDefault calls SetDefault and returns the table.
+func (tb *Table) WithDefault(column string, defaultValue string) *Table {
+	tb.SetDefault(column, defaultValue)
+	return tb
+}
+
+// WithPadding calls SetPadding and returns the table.
+func (tb *Table) WithPadding(n int) *Table {
+	tb.SetPadding(n)
+	return tb
+}
+
+// WithNullText calls SetNullText and returns the table.
+func (tb *Table) WithNullText(text string) *Table {
+	tb.SetNullText(text)
+	return tb
+}
+
+// WithRowNumbers calls ShowRowNumbers and returns the table.
+func (tb *Table) WithRowNumbers(enable bool) *Table {
+	tb.ShowRowNumbers(enable)
+	return tb
+}
+
+// WithMaxRowHeight calls SetMaxRowHeight and returns the table.
+func (tb *Table) WithMaxRowHeight(lines int) *Table {
+	tb.SetMaxRowHeight(lines)
+	return tb
+}
+
+// WithColumnColor calls SetColumnColor and returns the table.
+func (tb *Table) WithColumnColor(column string, display, fount, background int) *Table {
+	tb.SetColumnColor(column, display, fount, background)
+	return tb
+}
+
+// WithRow calls AddRow and returns the table. A row that can not be added is dropped, and the first error of AddRow
+// is kept for Err.
+func (tb *Table) WithRow(row interface{}) *Table {
+	err := tb.AddRow(row)
+	if err != nil && tb.err == nil {
+		tb.err = err
+	}
+	return tb
+}
+
+// Err returns the error of the columns given to gotable.New, or else the first error of AddRow met by WithRow. It
+// returns nil if the table was created and every row was added.
+func (tb *Table) Err() error {
+	return tb.err
+}
+
+// CreateFailedTable returns a table without columns whose Err method returns err. gotable.New returns it when the
+// columns are invalid, so that the chained methods do nothing and the error is reported at the end of the chain.
+func CreateFailedTable(err error) *Table {
+	tb := CreateTable(&Set{base: make([]*cell.Column, 0)})
+	tb.err = err
+	return tb
+}
@@ -0,0 +1,34 @@
+package table
+
+import (
+	"errors"
+	"github.com/liushuochen/gotable/exception"
+	"testing"
+)
+
+func TestWithRowErr(t *testing.T) {
+	tb := newTable(t, []string{"a", "b"}).WithRow([]string{"1", "2"})
+	if err := tb.Err(); err != nil {
+		t.Fatalf("Err() = %v, want nil", err)
+	}
+
+	tb.WithRow([]string{"1"}).WithRow(map[string]string{"c": "1"}).WithRow([]string{"3", "4"})
+	var target *exception.RowLengthNotEqualColumnsError
+	if !errors.As(tb.Err(), &target) {
+		t.Errorf("Err() = %v, want the first error, an *exception.RowLengthNotEqualColumnsError", tb.Err())
+	}
+	if tb.Length() != 2 {
+		t.Errorf("Length() = %d, want 2 as the invalid rows are dropped", tb.Length())
+	}
+}
+
+func TestCreateFailedTable(t *testing.T) {
+	cause := exception.ColumnsLength()
+	tb := CreateFailedTable(cause).WithAlign("a", R).WithRow([]string{"1"})
+	if tb.Err() != cause {
+		t.Errorf("Err() = %v, want %v", tb.Err(), cause)
+	}
+	if tb.ColumnCount() != 0 || tb.Length() != 0 {
+		t.Errorf("the table has %d columns and %d rows, want none", tb.ColumnCount(), tb.Length())
+	}
+}
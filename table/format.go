@@ -0,0 +1,112 @@
+package table
+
+import (
+	"github.com/liushuochen/gotable/cell"
+	"github.com/liushuochen/gotable/exception"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// columnFormat holds the alignment and the format a column had before AutoFormatNumbers changed them.
+type columnFormat struct {
+	align     int
+	formatter func(string) string
+}
+
+// AutoFormatNumbers detects the numeric columns, that is the columns whose non-empty values are all finite numbers,
+// aligns them right and prints their values with the given number of decimals. Text columns are left untouched and
+// the stored values do not change. It can be called again after more rows are added: a column it formatted that is
+// no longer numeric gets back the alignment and the format it had before.
+func (tb *Table) AutoFormatNumbers(decimals int) {
+	if decimals < 0 {
+		decimals = 0
+	}
+	if tb.autoFormatted == nil {
+		tb.autoFormatted = make(map[*cell.Column]columnFormat)
+	}
+
+	for _, col := range tb.Columns.base {
+		previous, formatted := tb.autoFormatted[col]
+		if !tb.numeric(col.Original()) {
+			if formatted {
+				col.SetAlign(previous.align)
+				col.SetFormatter(previous.formatter)
+				delete(tb.autoFormatted, col)
+			}
+			continue
+		}
+
+		if !formatted {
+			tb.autoFormatted[col] = columnFormat{col.Align(), col.Formatter()}
+		}
+		col.SetAlign(R)
+		col.SetFormatter(func(value string) string {
+			number, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+			if err != nil {
+				return value
+			}
+			return strconv.FormatFloat(number, 'f', decimals, 64)
+		})
+	}
+}
+
+// SetColumnFormat sets the function that formats the values of the column when the table is printed, e.g.
+// NumberFormat(2). The stored values do not change, so GetValues returns them as they were added. A nil function
+// prints the values as they are.
+// It returns an *exception.ColumnDoNotExistError if the column does not exist.
+func (tb *Table) SetColumnFormat(column string, fn func(string) string) error {
+	col := tb.Columns.Get(column)
+	if col == nil {
+		return exception.ColumnDoNotExist(column)
+	}
+	col.SetFormatter(fn)
+	return nil
+}
+
+// NumberFormat returns a column format that prints numbers with the given number of decimals and a comma between
+// groups of thousands, e.g. "1234567.5" as "1,234,567.50" with 2 decimals. Values that are not numbers, infinities and
+// NaN included, are printed as they are.
+func NumberFormat(decimals int) func(string) string {
+	if decimals < 0 {
+		decimals = 0
+	}
+
+	return func(value string) string {
+		number, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+		if err != nil || math.IsInf(number, 0) || math.IsNaN(number) {
+			return value
+		}
+
+		s := strconv.FormatFloat(number, 'f', decimals, 64)
+		sign := ""
+		if strings.HasPrefix(s, "-") {
+			sign, s = "-", s[1:]
+		}
+		integer, fraction := s, ""
+		if dot := strings.Index(s, "."); dot != -1 {
+			integer, fraction = s[:dot], s[dot:]
+		}
+		for index := len(integer) - 3; index > 0; index -= 3 {
+			integer = integer[:index] + "," + integer[index:]
+		}
+		return sign + integer + fraction
+	}
+}
+
+// numeric reports whether the column has at least one value and all of its non-empty values are finite numbers.
+func (tb *Table) numeric(column string) bool {
+	found := false
+	for _, row := range tb.Row {
+		value := strings.TrimSpace(row[column].String())
+		if value == "" {
+			continue
+		}
+		number, err := strconv.ParseFloat(value, 64)
+		if err != nil || math.IsInf(number, 0) || math.IsNaN(number) {
+			return false
+		}
+		found = true
+	}
+	return found
+}
@@ -0,0 +1,58 @@
+package table
+
+import "testing"
+
+func TestNumberFormat(t *testing.T) {
+	cases := []struct {
+		decimals int
+		value    string
+		want     string
+	}{
+		{2, "1234567.5", "1,234,567.50"},
+		{0, "-1234", "-1,234"},
+		{2, "123", "123.00"},
+		{2, " 1000 ", "1,000.00"},
+		{2, "abc", "abc"},
+		{2, "", ""},
+		{2, "Inf", "Inf"},
+		{2, "-Infinity", "-Infinity"},
+		{2, "+inf", "+inf"},
+		{2, "NaN", "NaN"},
+	}
+	for _, c := range cases {
+		if got := NumberFormat(c.decimals)(c.value); got != c.want {
+			t.Errorf("NumberFormat(%d)(%q) = %q, want %q", c.decimals, c.value, got, c.want)
+		}
+	}
+}
+
+func TestAutoFormatNumbers(t *testing.T) {
+	tb := newTable(t, []string{"name", "price", "ratio"},
+		[]string{"a", "1.5", "NaN"},
+		[]string{"b", "20", "0.5"},
+	)
+	tb.Align("price", L)
+	if err := tb.SetColumnFormat("price", func(value string) string { return "$" + value }); err != nil {
+		t.Fatal(err)
+	}
+	tb.AutoFormatNumbers(2)
+
+	price, ratio := tb.Columns.Get("price"), tb.Columns.Get("ratio")
+	if price.Align() != R || price.Formatter() == nil || price.Formatter()("1.5") != "1.50" {
+		t.Errorf("price is not formatted as a number")
+	}
+	if ratio.Align() != C || ratio.Formatter() != nil {
+		t.Errorf("ratio holds NaN and must not be formatted")
+	}
+	if name := tb.Columns.Get("name"); name.Align() != C || name.Formatter() != nil {
+		t.Errorf("name is not numeric and must not be formatted")
+	}
+
+	if err := tb.AddRow([]string{"c", "free", "1"}); err != nil {
+		t.Fatal(err)
+	}
+	tb.AutoFormatNumbers(2)
+	if price.Align() != L || price.Formatter() == nil || price.Formatter()("free") != "$free" {
+		t.Errorf("price is no longer numeric and must get back its alignment and its format")
+	}
+}
@@ -0,0 +1,29 @@
+package table
+
+import (
+	"strconv"
+	"strings"
+)
+
+// ToGoLiteral returns Go code that rebuilds the table into a variable named varName: a gotable.New call with the
+// columns followed by an AddRow call for each row, e.g. to freeze a table read from a file into a test fixture.
+// Values are written as quoted Go strings. The settings of the table are not written.
+func (tb *Table) ToGoLiteral(varName string) string {
+	tb.rLock()
+	defer tb.rUnlock()
+	columns := make([]string, 0)
+	for _, col := range tb.Columns.base {
+		columns = append(columns, strconv.Quote(col.Original()))
+	}
+
+	builder := new(strings.Builder)
+	builder.WriteString(varName + " := gotable.New(" + strings.Join(columns, ", ") + ")\n")
+	for _, row := range tb.Row {
+		values := make([]string, 0)
+		for _, col := range tb.Columns.base {
+			values = append(values, strconv.Quote(row[col.Original()].String()))
+		}
+		builder.WriteString(varName + ".AddRow([]string{" + strings.Join(values, ", ") + "})\n")
+	}
+	return builder.String()
+}
@@ -0,0 +1,11 @@
+package table
+
+import "testing"
+
+func TestToGoLiteral(t *testing.T) {
+	tb := newTable(t, []string{"id", "name"}, []string{"1", `a "b"`})
+	want := `tb := gotable.New("id", "name")` + "\n" + `tb.AddRow([]string{"1", "a \"b\""})` + "\n"
+	if got := tb.ToGoLiteral("tb"); got != want {
+		t.Errorf("got\n%s\nwant\n%s", got, want)
+	}
+}
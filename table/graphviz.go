@@ -0,0 +1,51 @@
+package table
+
+import (
+	"fmt"
+	"strings"
+)
+
+var graphvizEscaper = strings.NewReplacer(
+	"&", "&amp;",
+	"<", "&lt;",
+	">", "&gt;",
+	`"`, "&quot;",
+	"\n", "<BR/>",
+)
+
+// ToGraphvizLabel returns the table as a Graphviz HTML-like label, which can be used as the label of a node in a dot
+// graph, e.g. node [shape=plaintext, label=<...>]. The header is printed in bold and the alignment of each column is
+// mapped to the ALIGN attribute of its cells. Special characters are escaped and line breaks become <BR/>.
+func (tb *Table) ToGraphvizLabel() (string, error) {
+	tb.rLock()
+	defer tb.rUnlock()
+	builder := new(strings.Builder)
+	builder.WriteString(`<TABLE BORDER="0" CELLBORDER="1" CELLSPACING="0">` + "\n<TR>")
+	for _, col := range tb.Columns.base {
+		fmt.Fprintf(builder, `<TD ALIGN="%s"><B>%s</B></TD>`, graphvizAlign(col.Align()),
+			graphvizEscaper.Replace(col.Original()))
+	}
+	builder.WriteString("</TR>\n")
+
+	for _, row := range tb.Row {
+		builder.WriteString("<TR>")
+		for _, col := range tb.Columns.base {
+			fmt.Fprintf(builder, `<TD ALIGN="%s">%s</TD>`, graphvizAlign(col.Align()),
+				graphvizEscaper.Replace(row[col.Original()].String()))
+		}
+		builder.WriteString("</TR>\n")
+	}
+	builder.WriteString("</TABLE>")
+	return builder.String(), nil
+}
+
+func graphvizAlign(mode int) string {
+	switch mode {
+	case L:
+		return "LEFT"
+	case R:
+		return "RIGHT"
+	default:
+		return "CENTER"
+	}
+}
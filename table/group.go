@@ -0,0 +1,148 @@
+package table
+
+import (
+	"github.com/liushuochen/gotable/cell"
+	"github.com/liushuochen/gotable/exception"
+	"github.com/liushuochen/gotable/util"
+	"strings"
+)
+
+// groupSpan is a run of adjacent columns, from start to end inclusive, that belong to the same group.
+type groupSpan struct {
+	name  string
+	start int
+	end   int
+}
+
+// SetColumnGroup sets the group of the columns. The group name is printed in an extra header line above the column
+// names, centered over its member columns. Columns that were in the group before but are not in columns leave it,
+// and an empty group name removes the columns from their group. Members that are not adjacent are printed as
+// separate spans with the same name.
+// It returns an *exception.ColumnDoNotExistError if a column does not exist.
+func (tb *Table) SetColumnGroup(groupName string, columns []string) error {
+	for _, column := range columns {
+		if !tb.Columns.Exist(column) {
+			return exception.ColumnDoNotExist(column)
+		}
+	}
+
+	if groupName != "" {
+		for _, col := range tb.Columns.base {
+			if col.Group() == groupName {
+				col.SetGroup("")
+			}
+		}
+	}
+	for _, column := range columns {
+		tb.Columns.Get(column).SetGroup(groupName)
+	}
+	return nil
+}
+
+// AddHeaderGroup sets label as the group of columns, which must be a run of adjacent columns given in any order. The
+// label is printed centered over the columns in an extra header line, like SetColumnGroup does.
+// It returns an *exception.ColumnDoNotExistError if a column does not exist, and an
+// *exception.ColumnsNotAdjacentError if columns is empty, holds a column more than once or skips a column.
+func (tb *Table) AddHeaderGroup(label string, columns []string) error {
+	if len(columns) == 0 {
+		return exception.ColumnsNotAdjacent(columns)
+	}
+
+	positions := make(map[int]bool)
+	first := -1
+	for _, column := range columns {
+		position := tb.Columns.exist(column)
+		if position == -1 {
+			return exception.ColumnDoNotExist(column)
+		}
+		if positions[position] {
+			return exception.ColumnsNotAdjacent(columns)
+		}
+		positions[position] = true
+		if first == -1 || position < first {
+			first = position
+		}
+	}
+
+	for index := range columns {
+		if !positions[first+index] {
+			return exception.ColumnsNotAdjacent(columns)
+		}
+	}
+	return tb.SetColumnGroup(label, columns)
+}
+
+// grouped reports whether any column belongs to a group.
+func (tb *Table) grouped() bool {
+	for _, col := range tb.Columns.base {
+		if col.Group() != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// groupSpans returns the spans of adjacent columns that share a group. Ungrouped columns are not returned.
+func (tb *Table) groupSpans(columns []*cell.Column) []groupSpan {
+	spans := make([]groupSpan, 0)
+	for index, col := range columns {
+		if col.Group() == "" {
+			continue
+		}
+		last := len(spans) - 1
+		if last >= 0 && spans[last].end == index-1 && spans[last].name == col.Group() {
+			spans[last].end = index
+			continue
+		}
+		spans = append(spans, groupSpan{name: col.Group(), start: index, end: index})
+	}
+	return spans
+}
+
+// spanLength returns the printed width of a span, which includes the separators between its columns.
+func (tb *Table) spanLength(span groupSpan, l *layout) int {
+	length := 0
+	for index := span.start; index <= span.end; index++ {
+		length += tb.itemLength(l, index)
+		if index < span.end {
+			length += util.Length(tb.separator(l.columns, index))
+		}
+	}
+	return length
+}
+
+// allSpans returns the group spans together with a single column span for every ungrouped column, in column order.
+func (tb *Table) allSpans(columns []*cell.Column) []groupSpan {
+	spans := make([]groupSpan, 0)
+	grouped := tb.groupSpans(columns)
+	for index := 0; index < len(columns); {
+		if len(grouped) > 0 && grouped[0].start == index {
+			spans = append(spans, grouped[0])
+			index = grouped[0].end + 1
+			grouped = grouped[1:]
+			continue
+		}
+		spans = append(spans, groupSpan{start: index, end: index})
+		index++
+	}
+	return spans
+}
+
+// groupLine returns the line holding the group names.
+func (tb *Table) groupLine(l *layout) string {
+	s := tb.leftEdge()
+	for _, span := range tb.allSpans(l.columns) {
+		value, _ := center(cell.CreateData(span.name), tb.spanLength(span, l), " ", tb.leftBias)
+		s += value + tb.separator(l.columns, span.end)
+	}
+	return s
+}
+
+// groupBorderLine returns the border above the group line, which only has joints between spans.
+func (tb *Table) groupBorderLine(l *layout) string {
+	s := tb.borderLeftEdge()
+	for _, span := range tb.allSpans(l.columns) {
+		s += strings.Repeat("-", tb.spanLength(span, l)) + tb.joint(l.columns, span.end)
+	}
+	return s
+}
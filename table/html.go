@@ -0,0 +1,37 @@
+package table
+
+import (
+	"fmt"
+	"html"
+	"strings"
+)
+
+// ToHTMLWithData returns the table as an HTML <table> fragment. Every <tr> of the body carries a data-index
+// attribute with the row index and every cell carries a data-column attribute with the column name, so scripts can
+// bind behavior to the rendered table. Values are HTML-escaped, alignment and column colors become inline styles.
+func (tb *Table) ToHTMLWithData() (string, error) {
+	tb.rLock()
+	defer tb.rUnlock()
+	builder := new(strings.Builder)
+	builder.WriteString("<table>\n<thead>\n<tr>")
+	for _, col := range tb.Columns.base {
+		style := "text-align:" + col.AlignString()
+		if col.Color() != nil && col.Color().Style() != "" {
+			style += ";" + col.Color().Style()
+		}
+		fmt.Fprintf(builder, `<th data-column="%s" style="%s">%s</th>`,
+			html.EscapeString(col.Original()), style, html.EscapeString(col.Original()))
+	}
+	builder.WriteString("</tr>\n</thead>\n<tbody>\n")
+
+	for index, row := range tb.Row {
+		fmt.Fprintf(builder, `<tr data-index="%d">`, index)
+		for _, col := range tb.Columns.base {
+			fmt.Fprintf(builder, `<td data-column="%s" style="text-align:%s">%s</td>`,
+				html.EscapeString(col.Original()), col.AlignString(), html.EscapeString(row[col.Original()].String()))
+		}
+		builder.WriteString("</tr>\n")
+	}
+	builder.WriteString("</tbody>\n</table>")
+	return builder.String(), nil
+}
@@ -0,0 +1,79 @@
+package table
+
+import "encoding/json"
+
+// JSONOption configures how the table is converted to JSON by JsonWithOptions.
+type JSONOption func(*jsonOptions)
+
+type jsonOptions struct {
+	orderedKeys bool
+	omitEmpty   bool
+}
+
+// OrderedKeys writes the keys of each row in column order, so that the output is deterministic. By default the keys
+// are sorted by name.
+func OrderedKeys() JSONOption {
+	return func(options *jsonOptions) {
+		options.orderedKeys = true
+	}
+}
+
+// OmitEmpty leaves out the keys whose value is empty. By default they are written as "".
+func OmitEmpty() JSONOption {
+	return func(options *jsonOptions) {
+		options.omitEmpty = true
+	}
+}
+
+// JsonWithOptions converts the table to JSON like Json, configured by the options.
+func (tb *Table) JsonWithOptions(indent int, options ...JSONOption) (string, error) {
+	o := new(jsonOptions)
+	for _, option := range options {
+		option(o)
+	}
+
+	bytes, err := tb.jsonWithOptions(indent, o)
+	if err != nil {
+		return "", err
+	}
+	return string(bytes), nil
+}
+
+type schemaColumn struct {
+	Name    string `json:"name"`
+	Align   string `json:"align"`
+	Default string `json:"default"`
+}
+
+type schema struct {
+	Columns []schemaColumn `json:"columns"`
+	Rows    [][]string     `json:"rows"`
+}
+
+// Schema returns the table as compact JSON holding the columns, with their name, alignment ("left", "center" or
+// "right") and default value, and the rows as arrays of values in column order, so that a front end can rebuild the
+// table faithfully:
+//   {"columns":[{"name":"id","align":"right","default":""}],"rows":[["1"]]}
+func (tb *Table) Schema() ([]byte, error) {
+	tb.rLock()
+	defer tb.rUnlock()
+	s := schema{Columns: make([]schemaColumn, 0), Rows: make([][]string, 0)}
+	for _, col := range tb.Columns.base {
+		align := "center"
+		switch col.Align() {
+		case L:
+			align = "left"
+		case R:
+			align = "right"
+		}
+		s.Columns = append(s.Columns, schemaColumn{Name: col.Original(), Align: align, Default: col.Default()})
+	}
+	for _, row := range tb.Row {
+		values := make([]string, 0)
+		for _, col := range tb.Columns.base {
+			values = append(values, row[col.Original()].String())
+		}
+		s.Rows = append(s.Rows, values)
+	}
+	return json.Marshal(s)
+}
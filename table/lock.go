@@ -0,0 +1,45 @@
+package table
+
+import "sync"
+
+// EnableConcurrency makes the table safe for use by multiple goroutines. Once enabled, the following methods lock
+// the table: AddRow, AddRows, AddRowsStrict, InsertRow, AppendCSVFile, AddColumn, AddColumnWithDefault,
+// AddComputedColumn, Clear, ClearRows, SetMaxRows, Distinct, Reverse, CollapseBy, SetCellAlign, RenameColumn,
+// Length, Empty, ColumnCount, Size, GetColumns, GetValues, Column, ColumnWidth, Exist, Count, IndexOf, ForEach,
+// ScanRow, Hash, PageCount, PrintTable, PrintNewRows, PrintHead, PrintPage, PrintVertical, RenderWithLineHook,
+// Width, Height, Json, JsonWithOptions, WriteJSON, ToJsonFile, JSONL, ToJSONLFile, Schema, Markdown, PrintMarkdown,
+// OrgMode, TOML, ToTOMLFile, YAML, ToYAMLFile, CSV, ToCSVFile, ToXLSXFile, FixedWidth, ToSQL, ToGraphvizLabel,
+// ToHTMLWithData, ToGoLiteral, ToPNGFile, SliceRows, GroupBy and Diff. Diff locks the other table while it copies
+// it, before locking the table, so the two locks are never held together. ForEach does not hold the lock while fn
+// runs, so fn may call these methods. Other methods, and direct access to the Columns and Row fields, are not
+// guarded and must not run concurrently with them. Tables are not locked by default, which avoids the cost of
+// locking for single goroutine use.
+func (tb *Table) EnableConcurrency() {
+	if tb.mu == nil {
+		tb.mu = new(sync.RWMutex)
+	}
+}
+
+func (tb *Table) lock() {
+	if tb.mu != nil {
+		tb.mu.Lock()
+	}
+}
+
+func (tb *Table) unlock() {
+	if tb.mu != nil {
+		tb.mu.Unlock()
+	}
+}
+
+func (tb *Table) rLock() {
+	if tb.mu != nil {
+		tb.mu.RLock()
+	}
+}
+
+func (tb *Table) rUnlock() {
+	if tb.mu != nil {
+		tb.mu.RUnlock()
+	}
+}
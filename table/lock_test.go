@@ -0,0 +1,114 @@
+package table
+
+import (
+	"io/ioutil"
+	"strconv"
+	"sync"
+	"testing"
+)
+
+// TestEnableConcurrency is meant to be run with -race.
+func TestEnableConcurrency(t *testing.T) {
+	tb := newTable(t, []string{"id", "name"})
+	tb.EnableConcurrency()
+
+	const writers, rowsPerWriter = 16, 100
+	var wg sync.WaitGroup
+	for w := 0; w < writers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < rowsPerWriter; i++ {
+				err := tb.AddRow(map[string]string{"id": strconv.Itoa(w*rowsPerWriter + i), "name": "n"})
+				if err != nil {
+					t.Error(err)
+					return
+				}
+			}
+		}(w)
+	}
+	for r := 0; r < 4; r++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < rowsPerWriter; i++ {
+				tb.Length()
+				tb.GetValues()
+				tb.Count(map[string]string{"name": "n"})
+				if _, err := tb.JSONL(); err != nil {
+					t.Error(err)
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got := tb.Length(); got != writers*rowsPerWriter {
+		t.Fatalf("got %d rows, want %d", got, writers*rowsPerWriter)
+	}
+	if got := tb.Distinct(); got != 0 {
+		t.Errorf("Distinct removed %d rows, want 0", got)
+	}
+}
+
+// TestEnableConcurrencyExporters is meant to be run with -race.
+func TestEnableConcurrencyExporters(t *testing.T) {
+	tb := newTable(t, []string{"id", "name"}, []string{"0", "n"})
+	other := newTable(t, []string{"name", "id"}, []string{"n", "0"})
+	tb.EnableConcurrency()
+	other.EnableConcurrency()
+
+	const rows = 100
+	var wg sync.WaitGroup
+	for _, table := range []*Table{tb, other} {
+		wg.Add(1)
+		go func(table *Table) {
+			defer wg.Done()
+			for i := 1; i < rows; i++ {
+				err := table.AddRow(map[string]string{"id": strconv.Itoa(i), "name": "n"})
+				if err != nil {
+					t.Error(err)
+					return
+				}
+			}
+		}(table)
+	}
+
+	exporters := []func() error{
+		func() error { _, err := tb.YAML(); return err },
+		func() error { _, err := tb.CSV(); return err },
+		func() error { _, err := tb.FixedWidth(); return err },
+		func() error { _, err := tb.ToSQL("t", StandardSQL); return err },
+		func() error { _, err := tb.ToGraphvizLabel(); return err },
+		func() error { _, err := tb.ToHTMLWithData(); return err },
+		func() error { tb.ToGoLiteral("tb"); return nil },
+		func() error { return tb.RenderWithLineHook(ioutil.Discard, nil) },
+		func() error { tb.Width(); tb.Height(); return nil },
+		func() error { _, err := tb.SliceRows(0, 1); return err },
+		func() error { _, err := tb.GroupBy("name"); return err },
+		func() error { _, _, err := tb.Diff(other); return err },
+		func() error { _, _, err := other.Diff(tb); return err },
+	}
+	for _, export := range exporters {
+		wg.Add(1)
+		go func(export func() error) {
+			defer wg.Done()
+			for i := 0; i < rows; i++ {
+				if err := export(); err != nil {
+					t.Error(err)
+					return
+				}
+			}
+		}(export)
+	}
+	wg.Wait()
+
+	added, removed, err := tb.Diff(other)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(added) != 0 || len(removed) != 0 {
+		t.Errorf("got %d added and %d removed rows, want none", len(added), len(removed))
+	}
+}
@@ -0,0 +1,97 @@
+package table
+
+import (
+	"fmt"
+	"github.com/liushuochen/gotable/util"
+	"os"
+	"strings"
+)
+
+var markdownEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	`|`, `\|`,
+	"\r\n", "<br>",
+	"\n", "<br>",
+)
+
+// Markdown returns the table as a GitHub Flavored Markdown table. The alignment of each column is written in the
+// delimiter row, "\" and "|" are escaped with a backslash and line breaks become <br>. Cells are padded so that the
+// source lines up.
+func (tb *Table) Markdown() (string, error) {
+	tb.rLock()
+	defer tb.rUnlock()
+	rows, widths := tb.pipeRows(markdownEscaper)
+
+	builder := new(strings.Builder)
+	for index, row := range rows {
+		builder.WriteString(pipeLine(row, widths))
+		if index == 0 {
+			builder.WriteString(tb.markdownDelimiter(widths))
+		}
+	}
+	return builder.String(), nil
+}
+
+// PrintMarkdown prints the Markdown rendering of the table in STDOUT. An error is printed in STDERR instead.
+func (tb *Table) PrintMarkdown() {
+	content, err := tb.Markdown()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	fmt.Print(content)
+}
+
+// pipeRows returns the header and the rows of the table escaped by escaper, and the width of each column, at least 3
+// characters, for a table whose cells are separated by pipes.
+func (tb *Table) pipeRows(escaper *strings.Replacer) ([][]string, []int) {
+	header := make([]string, 0)
+	for _, col := range tb.Columns.base {
+		header = append(header, col.Original())
+	}
+	rows := [][]string{header}
+	for _, row := range tb.Row {
+		values := make([]string, 0)
+		for _, col := range tb.Columns.base {
+			values = append(values, row[col.Original()].String())
+		}
+		rows = append(rows, values)
+	}
+
+	widths := make([]int, len(tb.Columns.base))
+	for _, row := range rows {
+		for index := range row {
+			row[index] = escaper.Replace(row[index])
+			widths[index] = max(widths[index], util.Length(row[index]))
+		}
+	}
+	for index := range widths {
+		widths[index] = max(widths[index], 3)
+	}
+	return rows, widths
+}
+
+// pipeLine returns the values padded to the widths and separated by pipes, as a line of a Markdown or Org table.
+func pipeLine(values []string, widths []int) string {
+	s := "|"
+	for index, value := range values {
+		s += " " + value + block(widths[index]-util.Length(value)) + " |"
+	}
+	return s + "\n"
+}
+
+// markdownDelimiter returns the row between the header and the rows, which holds the alignment of the columns.
+func (tb *Table) markdownDelimiter(widths []int) string {
+	s := "|"
+	for index, col := range tb.Columns.base {
+		switch col.Align() {
+		case L:
+			s += " :" + strings.Repeat("-", widths[index]-1) + " |"
+		case R:
+			s += " " + strings.Repeat("-", widths[index]-1) + ": |"
+		default:
+			s += " :" + strings.Repeat("-", widths[index]-2) + ": |"
+		}
+	}
+	return s + "\n"
+}
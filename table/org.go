@@ -0,0 +1,74 @@
+package table
+
+import (
+	"strings"
+)
+
+var orgEscaper = strings.NewReplacer(
+	`|`, `\vert{}`,
+	"\r\n", " ",
+	"\n", " ",
+)
+
+// OrgOption configures how the table is converted to an Org mode table.
+type OrgOption func(*orgOptions)
+
+type orgOptions struct {
+	alignmentCookies bool
+}
+
+// AlignmentCookies writes a row of alignment cookies, <l>, <c> or <r>, below the header, so that Emacs aligns the
+// columns like the table does. By default Org mode aligns numbers right and text left.
+func AlignmentCookies() OrgOption {
+	return func(options *orgOptions) {
+		options.alignmentCookies = true
+	}
+}
+
+// OrgMode returns the table as an Emacs Org mode table, with a horizontal rule below the header. "|" is written as
+// \vert{} and line breaks become spaces. Cells are padded so that the source lines up.
+func (tb *Table) OrgMode(options ...OrgOption) (string, error) {
+	tb.rLock()
+	defer tb.rUnlock()
+	o := new(orgOptions)
+	for _, option := range options {
+		option(o)
+	}
+
+	rows, widths := tb.pipeRows(orgEscaper)
+	builder := new(strings.Builder)
+	for index, row := range rows {
+		builder.WriteString(pipeLine(row, widths))
+		if index == 0 {
+			builder.WriteString(orgRule(widths))
+			if o.alignmentCookies {
+				builder.WriteString(pipeLine(tb.orgCookies(), widths))
+			}
+		}
+	}
+	return builder.String(), nil
+}
+
+// orgRule returns the horizontal rule below the header, e.g. |-----+-----|.
+func orgRule(widths []int) string {
+	parts := make([]string, 0)
+	for _, width := range widths {
+		parts = append(parts, strings.Repeat("-", width+2))
+	}
+	return "|" + strings.Join(parts, "+") + "|\n"
+}
+
+func (tb *Table) orgCookies() []string {
+	cookies := make([]string, 0)
+	for _, col := range tb.Columns.base {
+		switch col.Align() {
+		case L:
+			cookies = append(cookies, "<l>")
+		case R:
+			cookies = append(cookies, "<r>")
+		default:
+			cookies = append(cookies, "<c>")
+		}
+	}
+	return cookies
+}
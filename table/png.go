@@ -0,0 +1,138 @@
+package table
+
+import (
+	"github.com/liushuochen/gotable/cell"
+	"github.com/liushuochen/gotable/exception"
+	"github.com/liushuochen/gotable/util"
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/basicfont"
+	"golang.org/x/image/math/fixed"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"os"
+	"strings"
+)
+
+const (
+	pngMargin       = 8
+	pngCharWidth    = 7
+	pngLineHeight   = 17
+	pngTextBaseline = 13
+)
+
+// ToPNGFile draws the table onto a PNG image using a fixed 7x13 font. Column widths come from the same column length
+// logic as PrintTable, grid lines are drawn when the border is open, and column alignment and colors are honored.
+// The values are formatted like in PrintTable, a multi-line value is drawn on several lines and a wide character,
+// e.g. a Chinese character, takes two character cells. The built-in font only covers ASCII, other characters are
+// drawn as placeholders.
+func (tb *Table) ToPNGFile(path string) error {
+	if !util.IsPNGFile(path) {
+		return exception.NotARegularPNGFile(path)
+	}
+
+	tb.rLock()
+	defer tb.rUnlock()
+	columnMaxLength := tb.columnMaxLength(tb.Row)
+	xs := []int{pngMargin}
+	for _, col := range tb.Columns.base {
+		width := (columnMaxLength[col.Original()] + 2) * pngCharWidth
+		xs = append(xs, xs[len(xs)-1]+width+1)
+	}
+
+	rows := [][]cell.Cell{tb.header(tb.Columns.base)}
+	for _, row := range tb.Row {
+		rows = append(rows, tb.cells(row))
+	}
+	heights := make([]int, 0)
+	lines := 0
+	for _, row := range rows {
+		height := 1
+		for _, c := range row {
+			height = max(height, len(strings.Split(c.String(), "\n")))
+		}
+		heights = append(heights, height)
+		lines += height
+	}
+	width := xs[len(xs)-1] + pngMargin
+	height := pngMargin*2 + lines*pngLineHeight + 2
+
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	draw.Draw(img, img.Bounds(), image.White, image.Point{}, draw.Src)
+	drawer := &font.Drawer{Dst: img, Face: basicfont.Face7x13}
+
+	// header
+	top := pngMargin + 1
+	for index, col := range tb.Columns.base {
+		ink := color.RGBA{0, 0, 0, 255}
+		if c := col.Color(); c != nil {
+			if background, ok := c.BackgroundRGBA(); ok {
+				rect := image.Rect(xs[index]+1, top, xs[index+1], top+heights[0]*pngLineHeight)
+				draw.Draw(img, rect, image.NewUniform(background), image.Point{}, draw.Src)
+			}
+			if fontColor, ok := c.FontRGBA(); ok {
+				ink = fontColor
+			}
+		}
+		tb.drawCell(drawer, ink, rows[0][index], col, columnMaxLength, xs[index], top)
+	}
+
+	// rows
+	y := top + heights[0]*pngLineHeight + 1
+	for i, row := range rows[1:] {
+		for index, col := range tb.Columns.base {
+			tb.drawCell(drawer, color.RGBA{0, 0, 0, 255}, row[index], col, columnMaxLength, xs[index], y)
+		}
+		y += heights[i+1] * pngLineHeight
+	}
+
+	if tb.border {
+		grid := image.NewUniform(color.RGBA{128, 128, 128, 255})
+		bottom := top + lines*pngLineHeight + 1
+		for _, x := range xs {
+			draw.Draw(img, image.Rect(x, pngMargin, x+1, bottom+1), grid, image.Point{}, draw.Src)
+		}
+		for _, y := range []int{pngMargin, top + heights[0]*pngLineHeight, bottom} {
+			draw.Draw(img, image.Rect(xs[0], y, xs[len(xs)-1]+1, y+1), grid, image.Point{}, draw.Src)
+		}
+	}
+
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0666)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	return png.Encode(file, img)
+}
+
+// drawCell draws the aligned lines of a cell whose left grid line is at x and whose top is at y. Each character
+// advances by its printed width, so that wide characters take two character cells and zero-width ones none.
+func (tb *Table) drawCell(drawer *font.Drawer, ink color.Color, c cell.Cell, col *cell.Column,
+	columnMaxLength map[string]int, x, y int) {
+	itemLen := columnMaxLength[col.Original()] + 2
+	drawer.Src = image.NewUniform(ink)
+	for index, line := range strings.Split(util.StripColor(c.String()), "\n") {
+		s := ""
+		data := cell.CreateData(line)
+		switch col.Align() {
+		case R:
+			s, _ = right(data, itemLen, " ")
+		case L:
+			s, _ = left(data, itemLen, " ")
+		default:
+			s, _ = center(data, itemLen, " ", tb.leftBias)
+		}
+
+		dot := x + 1
+		for _, r := range s {
+			width := util.Length(string(r))
+			if width == 0 {
+				continue
+			}
+			drawer.Dot = fixed.P(dot, y+index*pngLineHeight+pngTextBaseline)
+			drawer.DrawString(string(r))
+			dot += width * pngCharWidth
+		}
+	}
+}
@@ -0,0 +1,85 @@
+package table
+
+import (
+	"image"
+	"image/png"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// drawn reports whether a pixel of the rectangle is not white.
+func drawn(img image.Image, rect image.Rectangle) bool {
+	for y := rect.Min.Y; y < rect.Max.Y; y++ {
+		for x := rect.Min.X; x < rect.Max.X; x++ {
+			r, g, b, _ := img.At(x, y).RGBA()
+			if r != 0xffff || g != 0xffff || b != 0xffff {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func TestToPNGFileMultiLineAndWide(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gotable")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "table.png")
+
+	tb := newTable(t, []string{"a", "b"}, []string{"x\ny\nz", "北京"})
+	tb.Align("b", L)
+	if err := tb.ToPNGFile(path); err != nil {
+		t.Fatal(err)
+	}
+	file, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+	img, err := png.Decode(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// one header line and three lines for the row; column b is 4 characters wide for 北京
+	lines := 4
+	if got, want := img.Bounds().Dy(), pngMargin*2+lines*pngLineHeight+2; got != want {
+		t.Errorf("height = %d, want %d", got, want)
+	}
+	widthA, widthB := (1+2)*pngCharWidth+1, (4+2)*pngCharWidth+1
+	if got, want := img.Bounds().Dx(), pngMargin+widthA+widthB+pngMargin; got != want {
+		t.Errorf("width = %d, want %d", got, want)
+	}
+
+	// the third line of the row is drawn in column a, below the second one
+	top := pngMargin + 1 + pngLineHeight + 1
+	third := image.Rect(pngMargin+2, top+2*pngLineHeight, pngMargin+widthA-1, top+3*pngLineHeight)
+	if !drawn(img, third) {
+		t.Error("the third line of the multi-line value is not drawn")
+	}
+
+	// the second Chinese character is drawn two character cells after the first one
+	x := pngMargin + widthA + 1 + 2*pngCharWidth
+	second := image.Rect(x, top, x+pngCharWidth, top+pngLineHeight)
+	if !drawn(img, second) {
+		t.Error("the second wide character is not drawn after the first one")
+	}
+}
+
+func TestToPNGFileColoredHeader(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gotable")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	tb := newTable(t, []string{"a"}, []string{"1"})
+	tb.SetColumnColor("a", 0, 31, 0)
+	if err := tb.ToPNGFile(filepath.Join(dir, "table.png")); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -3,52 +3,472 @@ package table
 import (
 	"fmt"
 	"github.com/liushuochen/gotable/cell"
+	"github.com/liushuochen/gotable/color"
+	"github.com/liushuochen/gotable/util"
+	"strconv"
+	"strings"
+	"unicode/utf8"
 )
 
 
-// This method print part of table data in STDOUT. It will be called twice in *table.PrintTable method.
-// Arguments:
-//   group: 		A map that storage column as key, data as value. Data is either "-" or row, if the value of data is
-//                  "-", the printGroup method will print the border of the table.
-//   columnMaxLen:  A map that storage column as key, max length of cell of column as value.
-func (tb *Table) printGroup(group []map[string]cell.Cell, columnMaxLen map[string]int) {
-	for _, item := range group {
-		for index, head := range tb.Columns.base {
-			itemLen := columnMaxLen[head.Original()]
-			if tb.border { itemLen += 2 }
-			s := ""
-			if item[head.String()].String() == "-" {
-				if tb.border {
-					s, _ = center(item[head.String()], itemLen, "-")
-				}
-			} else {
-				switch head.Align() {
-				case R:
-					s, _ = right(item[head.String()], itemLen, " ")
-				case L:
-					s, _ = left(item[head.String()], itemLen, " ")
-				default:
-					s, _ = center(item[head.String()], itemLen, " ")
-				}
+// rowStyle holds the settings a row of cells is printed with: the color of the row, or nil, and the alignment and
+// the color of each cell in print order, -1 meaning the alignment of the column and nil the color of the row.
+type rowStyle struct {
+	paint  *color.Color
+	aligns []int
+	paints []*color.Color
+}
+
+// layout holds the printed columns and the max length of the cells of each of them, in print order.
+type layout struct {
+	columns []*cell.Column
+	widths  []int
+}
+
+// render returns the lines of the table printing the rows tb.Row[start:end]: the title, the top border, the header,
+// the separator and the lines of each row followed by the bottom border. Border lines are only rendered when the
+// border is open. Column widths are computed from the printed rows, or from all the rows when fullWidth is true so
+// that the parts of a table line up. The table is fitted to the width set by FitWidth.
+func (tb *Table) render(start, end int, fullWidth bool) []string {
+	return tb.renderWidth(start, end, fullWidth, tb.fitWidth)
+}
+
+// renderWidth returns the lines of the table like render, fitted to maxWidth. A maxWidth less than or equal to 0
+// means unlimited.
+func (tb *Table) renderWidth(start, end int, fullWidth bool, maxWidth int) []string {
+	header, body, styles, l := tb.measure(start, end, fullWidth, maxWidth)
+
+	lines := make([]string, 0)
+	if tb.grouped() {
+		if tb.border && tb.sides.top {
+			lines = append(lines, tb.groupBorderLine(l))
+		}
+		lines = append(lines, tb.groupLine(l))
+	}
+	if tb.border && (tb.grouped() && tb.sides.innerH || !tb.grouped() && tb.sides.top) {
+		lines = append(lines, tb.borderLine(l))
+	}
+
+	lines = append(lines, tb.rowLines(header, l, true, rowStyle{})...)
+	if tb.border && tb.sides.innerH {
+		lines = append(lines, tb.borderLine(l))
+	}
+
+	for index, cells := range body {
+		if tb.headerRepeat > 0 && index > 0 && index%tb.headerRepeat == 0 {
+			if tb.border && tb.sides.innerH {
+				lines = append(lines, tb.borderLine(l))
+			}
+			lines = append(lines, tb.rowLines(header, l, true, rowStyle{})...)
+			if tb.border && tb.sides.innerH {
+				lines = append(lines, tb.borderLine(l))
+			}
+		}
+		lines = append(lines, tb.rowLines(cells, l, false, styles[index])...)
+	}
+	// without rows, the separator below the header closes the grid, unless the inner horizontal border is hidden
+	if tb.border && tb.sides.bottom && (len(body) > 0 || !tb.sides.innerH) {
+		lines = append(lines, tb.borderLine(l))
+	}
+
+	if tb.title != "" {
+		width := 0
+		for _, line := range lines {
+			width = max(width, util.Length(util.StripColor(line)))
+		}
+		title, _ := center(cell.CreateData(tb.title), width, " ", tb.leftBias)
+		lines = append([]string{title}, lines...)
+	}
+
+	if tb.trimTrailingSpace {
+		for index := range lines {
+			lines[index] = strings.TrimRight(lines[index], " ")
+		}
+	}
+	return lines
+}
+
+// bodyLines returns the lines the rows tb.Row[start:end] are printed on, without header and borders, with the column
+// widths of the whole table fitted to maxWidth. The widths are returned too.
+func (tb *Table) bodyLines(start, end int, maxWidth int) ([]string, []int) {
+	_, body, styles, l := tb.measure(start, end, true, maxWidth)
+	lines := make([]string, 0)
+	for index, cells := range body {
+		lines = append(lines, tb.rowLines(cells, l, false, styles[index])...)
+	}
+	if tb.trimTrailingSpace {
+		for index := range lines {
+			lines[index] = strings.TrimRight(lines[index], " ")
+		}
+	}
+	return lines, l.widths
+}
+
+// measure returns the header and the rows tb.Row[start:end] as cells in print order, the styles of the rows and the
+// layout of the table, computed like in renderWidth.
+func (tb *Table) measure(start, end int, fullWidth bool, maxWidth int) ([]cell.Cell, [][]cell.Cell, []rowStyle,
+	*layout) {
+	columns := tb.printColumns()
+	header := tb.header(columns)
+	measured := [][]cell.Cell{header}
+	body := make([][]cell.Cell, 0)
+	styles := make([]rowStyle, 0)
+	for index, row := range tb.Row {
+		printed := index >= start && index < end
+		if !printed && !fullWidth {
+			continue
+		}
+
+		cells := tb.cells(row)
+		if tb.rowNumbers {
+			cells = append([]cell.Cell{cell.CreateData(strconv.Itoa(index + 1))}, cells...)
+		}
+		measured = append(measured, cells)
+		if printed {
+			body = append(body, cells)
+			styles = append(styles, tb.rowStyle(row))
+		}
+	}
+	l := tb.layout(columns, measured)
+	if maxWidth > 0 {
+		tb.fit(l, maxWidth)
+	}
+	return header, body, styles, l
+}
+
+// printColumns returns the columns that are printed: the table columns, preceded by the row number column when
+// row numbers are shown.
+func (tb *Table) printColumns() []*cell.Column {
+	if !tb.rowNumbers {
+		return tb.Columns.base
+	}
+
+	return append([]*cell.Column{cell.CreateColumn("#")}, tb.Columns.base...)
+}
+
+// header returns the printed columns as cells, with their names transformed by the header formatter.
+func (tb *Table) header(columns []*cell.Column) []cell.Cell {
+	cells := make([]cell.Cell, 0)
+	for _, col := range columns {
+		if tb.headerFormatter == nil {
+			cells = append(cells, col)
+			continue
+		}
+
+		h := cell.CreateColumn(tb.headerFormatter(col.Original()))
+		if c := col.Color(); c != nil {
+			h.SetColor(c.Display, c.Font, c.Background)
+		}
+		cells = append(cells, h)
+	}
+	return cells
+}
+
+// cells returns the cells of a row in column order, formatted by the column formatters. The cells are trimmed when
+// TrimCells is enabled, empty cells are replaced by the null text, lists are split into bulleted lines and tabs are
+// expanded to spaces.
+func (tb *Table) cells(row map[string]cell.Cell) []cell.Cell {
+	cells := make([]cell.Cell, 0)
+	for _, col := range tb.Columns.base {
+		c := row[col.Original()]
+		if tb.trimCells && strings.TrimSpace(c.String()) != c.String() {
+			c = cell.CreateData(strings.TrimSpace(c.String()))
+		}
+		if c.String() == "" && tb.nullText != "" {
+			c = cell.CreateData(tb.nullText)
+		} else if col.Formatter() != nil {
+			c = cell.CreateData(col.Formatter()(c.String()))
+		}
+		if sep := col.ListSeparator(); sep != "" && strings.Contains(c.String(), sep) {
+			items := strings.Split(c.String(), sep)
+			for index := range items {
+				items[index] = "• " + strings.TrimSpace(items[index])
+			}
+			c = cell.CreateData(strings.Join(items, "\n"))
+		}
+		if strings.Contains(c.String(), "\t") {
+			c = cell.CreateData(util.ExpandTabs(c.String(), tb.tabWidth))
+		}
+		cells = append(cells, c)
+	}
+	return cells
+}
+
+// layout computes the max length of the cells of each column from the given rows of cells, the header included.
+// The length of a multi-line cell is the length of its longest line.
+func (tb *Table) layout(columns []*cell.Column, rows [][]cell.Cell) *layout {
+	l := &layout{columns: columns, widths: make([]int, len(columns))}
+	for _, row := range rows {
+		for index, lines := range tb.physical(row) {
+			for _, line := range lines {
+				l.widths[index] = max(l.widths[index], line.Length())
 			}
+		}
+	}
+
+	// widen the last column of a group whose name is longer than the columns it spans
+	for _, span := range tb.groupSpans(columns) {
+		need := util.Length(span.name) + 2*tb.cellPadding()
+		extra := need - tb.spanLength(span, l)
+		if extra > 0 {
+			l.widths[span.end] += extra
+		}
+	}
+	return l
+}
+
+// fit shrinks the columns of l so that the printed width of the table is not greater than maxWidth. Every column
+// gives up part of its width above its minimum, in proportion to it. Columns at their minimum are not shrunk.
+func (tb *Table) fit(l *layout, maxWidth int) {
+	total := util.Length(tb.leftEdge())
+	slack := make([]int, len(l.columns))
+	totalSlack := 0
+	for index, col := range l.columns {
+		total += tb.itemLength(l, index) + util.Length(tb.separator(l.columns, index))
+		minimum := max(util.Length(col.Original()), 3)
+		if l.widths[index] > minimum {
+			slack[index] = l.widths[index] - minimum
+			totalSlack += slack[index]
+		}
+	}
 
-			icon := "|"
-			if item[head.String()].String() == "-" {
-				icon = "+"
+	excess := total - maxWidth
+	if excess <= 0 || totalSlack == 0 {
+		return
+	}
+	if excess > totalSlack {
+		excess = totalSlack
+	}
+
+	shrunk := 0
+	for index := range l.columns {
+		cut := slack[index] * excess / totalSlack
+		l.widths[index] -= cut
+		slack[index] -= cut
+		shrunk += cut
+	}
+	// the rounding leftover is taken from the columns that still have the most extra width
+	for shrunk < excess {
+		widest := 0
+		for index := range slack {
+			if slack[index] > slack[widest] {
+				widest = index
 			}
-			if !tb.border {
-				icon = " "
+		}
+		l.widths[widest]--
+		slack[widest]--
+		shrunk++
+	}
+}
+
+// columnMaxLength returns a map that storage column as key, max length of cell of column as value.
+func (tb *Table) columnMaxLength(rows []map[string]cell.Cell) map[string]int {
+	cells := [][]cell.Cell{tb.header(tb.Columns.base)}
+	for _, row := range rows {
+		cells = append(cells, tb.cells(row))
+	}
+	l := tb.layout(tb.Columns.base, cells)
+
+	columnMaxLength := make(map[string]int)
+	for index, col := range l.columns {
+		columnMaxLength[col.Original()] = l.widths[index]
+	}
+	return columnMaxLength
+}
+
+// physical splits every cell, given in print order, into the lines it is printed on. When the row is higher than
+// the max row height, the lines are truncated and the last visible line ends with "...".
+func (tb *Table) physical(cells []cell.Cell) [][]cell.Cell {
+	result := make([][]cell.Cell, 0)
+	for _, c := range cells {
+		// A colored cell keeps its escape sequence around the whole value, so it can not be split.
+		if !strings.Contains(c.String(), "\n") || c.String() != c.Original() {
+			result = append(result, []cell.Cell{c})
+			continue
+		}
+
+		lines := make([]cell.Cell, 0)
+		for _, line := range strings.Split(c.String(), "\n") {
+			lines = append(lines, cell.CreateData(line))
+		}
+		result = append(result, lines)
+	}
+
+	if tb.maxRowHeight > 0 {
+		for index, lines := range result {
+			if len(lines) > tb.maxRowHeight {
+				lines = lines[:tb.maxRowHeight]
+				lines[len(lines)-1] = cell.CreateData(lines[len(lines)-1].String() + "...")
+				result[index] = lines
 			}
+		}
+	}
+	return result
+}
+
+// rowLines returns the lines a row, given in print order, is printed on with the style. The header row uses the
+// header alignment of the columns.
+func (tb *Table) rowLines(cells []cell.Cell, l *layout, header bool, style rowStyle) []string {
+	physical := tb.physical(cells)
+	height := 0
+	for _, lines := range physical {
+		height = max(height, len(lines))
+	}
 
-			if index == 0 {
-				s = icon + s + icon
+	result := make([]string, 0)
+	for i := 0; i < height; i++ {
+		line := make([]cell.Cell, 0)
+		for _, lines := range physical {
+			if i < len(lines) {
+				line = append(line, lines[i])
 			} else {
-				s = "" + s + icon
+				line = append(line, cell.CreateEmptyData())
 			}
-			fmt.Print(s)
 		}
-		fmt.Println()
+		result = append(result, tb.line(line, l, header, style))
+	}
+	return result
+}
+
+// line joins the cells, given in print order, into a single line of the table. The cells are printed in the colors
+// of the style, padding included.
+func (tb *Table) line(cells []cell.Cell, l *layout, header bool, style rowStyle) string {
+	s := tb.leftEdge()
+	for index, head := range l.columns {
+		itemLen := tb.itemLength(l, index)
+		c := cells[index]
+		if c.Length() > l.widths[index] && c.String() == c.Original() {
+			c = cell.CreateData(util.Truncate(c.String(), l.widths[index]))
+		}
+
+		align := head.Align()
+		fillchar := head.FillChar()
+		if header {
+			align = head.HeaderAlign()
+			fillchar = " "
+		} else if index < len(style.aligns) && style.aligns[index] != -1 {
+			align = style.aligns[index]
+		}
+		// in a right-to-left column, left and right are the start and the end of the text
+		if head.RTL() && align == L {
+			align = R
+		} else if head.RTL() && align == R {
+			align = L
+		}
+
+		// the value is aligned within the column width using the fill character, the padding is always spaces
+		padding := itemLen - l.widths[index]
+		value := ""
+		switch align {
+		case R:
+			value, _ = right(c, l.widths[index], fillchar)
+			value = block(padding) + value
+		case L:
+			value, _ = left(c, l.widths[index], fillchar)
+			value += block(padding)
+		default:
+			value, _ = center(c, l.widths[index], fillchar, tb.leftBias)
+			value = block(padding/2) + value + block(padding/2)
+		}
+		paint := style.paint
+		if index < len(style.paints) && style.paints[index] != nil {
+			paint = style.paints[index]
+		}
+		if paint != nil {
+			value = paint.Combine(value)
+		}
+		s += value + tb.separator(l.columns, index)
+	}
+	return s
+}
+
+// rowStyle returns the style a row is printed with: its color and the alignment and the color of its cells, in print
+// order.
+func (tb *Table) rowStyle(row map[string]cell.Cell) rowStyle {
+	aligns := make([]int, 0)
+	paints := make([]*color.Color, 0)
+	if tb.rowNumbers {
+		aligns = append(aligns, -1)
+		paints = append(paints, nil)
+	}
+	for _, col := range tb.Columns.base {
+		align := -1
+		if d, ok := row[col.Original()].(*cell.Data); ok {
+			align = d.Align()
+		}
+		aligns = append(aligns, align)
+		paints = append(paints, col.RuleColor(row[col.Original()].String()))
 	}
+	return rowStyle{paint: tb.rowColor(row), aligns: aligns, paints: paints}
+}
+
+// borderLine returns the border between the header and the rows, which is also used at the top and the bottom of
+// the table.
+func (tb *Table) borderLine(l *layout) string {
+	s := tb.borderLeftEdge()
+	for index := range l.columns {
+		s += strings.Repeat("-", tb.itemLength(l, index)) + tb.joint(l.columns, index)
+	}
+	return s
+}
+
+// leftEdge returns the string printed at the left edge of a line. A table without border has no left margin.
+func (tb *Table) leftEdge() string {
+	if !tb.border || !tb.sides.left {
+		return ""
+	}
+	return "|"
+}
+
+// borderLeftEdge returns the string printed at the left edge of a border line.
+func (tb *Table) borderLeftEdge() string {
+	if !tb.sides.left {
+		return ""
+	}
+	return "+"
+}
+
+// itemLength returns the printed width of the column at index, padding included.
+func (tb *Table) itemLength(l *layout, index int) int {
+	return l.widths[index] + 2*tb.cellPadding()
+}
+
+// cellPadding returns the number of spaces printed on each side of the cells: none when the border is closed or the
+// table is compact.
+func (tb *Table) cellPadding() int {
+	if !tb.border || tb.compact {
+		return 0
+	}
+	return tb.padding
+}
+
+// separator returns the separator printed after the column at index: the custom separator of the column if any,
+// otherwise "|" (the right edge for the last column) or, when the border is closed, a space between columns and
+// nothing after the last one. A compact table ignores the custom separators.
+func (tb *Table) separator(columns []*cell.Column, index int) string {
+	if columns[index].Separator() != "" && !tb.compact {
+		return columns[index].Separator()
+	}
+	if !tb.border {
+		if index == len(columns)-1 {
+			return ""
+		}
+		return " "
+	}
+	if index == len(columns)-1 && !tb.sides.right || index < len(columns)-1 && !tb.sides.innerV {
+		return ""
+	}
+	return "|"
+}
+
+// joint returns the part of a border line below or above the separator of the column at index. A custom column
+// separator is drawn as a joint followed by "-" up to the separator length.
+func (tb *Table) joint(columns []*cell.Column, index int) string {
+	length := util.Length(tb.separator(columns, index))
+	if length == 0 {
+		return ""
+	}
+	return "+" + strings.Repeat("-", length-1)
 }
 
 func max(x, y int) int {
@@ -58,8 +478,10 @@ func max(x, y int) int {
 	return y
 }
 
-func center(c cell.Cell, length int, fillchar string) (string, error) {
-	if len(fillchar) != 1 {
+// center pads c on both sides to length. When the padding is odd, the extra fill character goes to the right, or to
+// the left when leftBias is true.
+func center(c cell.Cell, length int, fillchar string, leftBias bool) (string, error) {
+	if utf8.RuneCountInString(fillchar) != 1 {
 		err := fmt.Errorf("the fill character must be exactly one" +
 			" character long")
 		return "", err
@@ -84,30 +506,33 @@ func center(c cell.Cell, length int, fillchar string) (string, error) {
 		}
 
 		behind := front + fillchar
+		if leftBias {
+			front, behind = behind, front
+		}
 		result = front + c.String() + behind
 	}
 	return result, nil
 }
 
 func left(c cell.Cell, length int, fillchar string) (string, error) {
-	if len(fillchar) != 1 {
+	if utf8.RuneCountInString(fillchar) != 1 {
 		err := fmt.Errorf("the fill character must be exactly one" +
 			" character long")
 		return "", err
 	}
 
-	result := c.String() + block(length - c.Length())
+	result := c.String() + strings.Repeat(fillchar, max(length - c.Length(), 0))
 	return result, nil
 }
 
 func right(c cell.Cell, length int, fillchar string) (string, error) {
-	if len(fillchar) != 1 {
+	if utf8.RuneCountInString(fillchar) != 1 {
 		err := fmt.Errorf("the fill character must be exactly one" +
 			" character long")
 		return "", err
 	}
 
-	result := block(length - c.Length()) + c.String()
+	result := strings.Repeat(fillchar, max(length - c.Length(), 0)) + c.String()
 	return result, nil
 }
 
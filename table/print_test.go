@@ -0,0 +1,214 @@
+package table
+
+import (
+	"flag"
+	"fmt"
+	"github.com/liushuochen/gotable/cell"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "update the golden files in testdata")
+
+// golden compares got with the content of testdata/name.golden, which is rewritten with got when -update is given.
+func golden(t *testing.T, name string, got string) {
+	t.Helper()
+	path := filepath.Join("testdata", name+".golden")
+	if *update {
+		if err := ioutil.WriteFile(path, []byte(got), 0666); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+
+	want, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != string(want) {
+		t.Errorf("%s:\ngot\n%s\nwant\n%s", path, got, want)
+	}
+}
+
+// rendered returns the lines PrintTable prints for tb, without colors.
+func rendered(tb *Table) string {
+	return strings.Join(tb.renderWidth(0, len(tb.Row), false, 0), "\n") + "\n"
+}
+
+func TestPrintNewRowsWithMaxRows(t *testing.T) {
+	tb := newTable(t, []string{"id", "name"}, []string{"1", "a"}, []string{"2", "b"})
+	tb.SetMaxRows(2)
+
+	steps := []struct {
+		rows [][]string
+		want string
+	}{
+		{nil, "+----+------+\n| id | name |\n+----+------+\n| 1  |  a   |\n| 2  |  b   |\n"},
+		{[][]string{{"3", "c"}, {"4", "d"}}, "| 3  |  c   |\n| 4  |  d   |\n"},
+		{[][]string{{"5", "e"}, {"6", "f"}, {"7", "g"}}, "| 6  |  f   |\n| 7  |  g   |\n"},
+		{nil, ""},
+	}
+	for index, step := range steps {
+		for _, row := range step.rows {
+			if err := tb.AddRow(row); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if got := captureStdout(t, tb.PrintNewRows); got != step.want {
+			t.Errorf("step %d:\ngot\n%s\nwant\n%s", index, got, step.want)
+		}
+	}
+}
+
+func TestPrintNewRowsReprintsAfterChange(t *testing.T) {
+	header := "+----+------+\n| id | name |\n+----+------+\n"
+	cases := []struct {
+		name   string
+		change func(tb *Table) error
+		want   string
+	}{
+		{"ClearRows", func(tb *Table) error {
+			tb.ClearRows()
+			return tb.AddRow([]string{"3", "c"})
+		}, header + "| 3  |  c   |\n"},
+		{"InsertRow", func(tb *Table) error {
+			return tb.InsertRow(0, []string{"3", "c"})
+		}, header + "| 3  |  c   |\n| 1  |  a   |\n| 2  |  b   |\n"},
+		{"Reverse", func(tb *Table) error {
+			tb.Reverse()
+			return nil
+		}, header + "| 2  |  b   |\n| 1  |  a   |\n"},
+	}
+	for _, c := range cases {
+		tb := newTable(t, []string{"id", "name"}, []string{"1", "a"}, []string{"2", "b"})
+		captureStdout(t, tb.PrintNewRows)
+		if err := c.change(tb); err != nil {
+			t.Fatalf("%s: %v", c.name, err)
+		}
+		if got := captureStdout(t, tb.PrintNewRows); got != c.want {
+			t.Errorf("%s:\ngot\n%s\nwant\n%s", c.name, got, c.want)
+		}
+	}
+}
+
+func TestCenter(t *testing.T) {
+	cases := []struct {
+		value    string
+		length   int
+		leftBias bool
+		want     string
+	}{
+		{"ab", 5, false, " ab  "},
+		{"ab", 5, true, "  ab "},
+		{"a", 4, false, " a  "},
+		{"a", 4, true, "  a "},
+		{"ab", 6, false, "  ab  "},
+		{"ab", 6, true, "  ab  "},
+		{"abc", 3, true, "abc"},
+		{"abcd", 3, false, "abcd"},
+		{"中", 5, false, " 中  "},
+		{"中", 5, true, "  中 "},
+	}
+	for _, c := range cases {
+		got, err := center(cell.CreateData(c.value), c.length, " ", c.leftBias)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != c.want {
+			t.Errorf("center(%q, %d, leftBias %v) = %q, want %q", c.value, c.length, c.leftBias, got, c.want)
+		}
+	}
+}
+
+func TestCenterFillChar(t *testing.T) {
+	got, err := center(cell.CreateData("a"), 4, "*", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != "*a**" {
+		t.Errorf("got %q, want %q", got, "*a**")
+	}
+	if _, err := center(cell.CreateData("a"), 4, "**", false); err == nil {
+		t.Error("want an error for a fill character longer than one character")
+	}
+}
+
+func TestSetCenterBias(t *testing.T) {
+	cases := []struct {
+		bias int
+		want string
+	}{
+		{R, "+------+\n| name |\n+------+\n|  ab  |\n|  a   |\n+------+"},
+		{L, "+------+\n| name |\n+------+\n|  ab  |\n|   a  |\n+------+"},
+		{C, "+------+\n| name |\n+------+\n|  ab  |\n|  a   |\n+------+"},
+	}
+	for _, c := range cases {
+		tb := newTable(t, []string{"name"}, []string{"ab"}, []string{"a"})
+		tb.SetCenterBias(c.bias)
+		if got := strings.Join(tb.render(0, tb.Length(), false), "\n"); got != c.want {
+			t.Errorf("bias %d:\ngot\n%s\nwant\n%s", c.bias, got, c.want)
+		}
+	}
+}
+
+func TestRenderBorderAndRowCount(t *testing.T) {
+	for _, border := range []bool{true, false} {
+		for _, count := range []int{0, 1, 3} {
+			tb := newTable(t, []string{"id", "name"})
+			for index := 0; index < count; index++ {
+				if err := tb.AddRow([]string{fmt.Sprint(index + 1), fmt.Sprint("name", index)}); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if !border {
+				tb.CloseBorder()
+			}
+
+			name := fmt.Sprintf("border_on_%d_rows", count)
+			if !border {
+				name = fmt.Sprintf("border_off_%d_rows", count)
+			}
+			golden(t, name, rendered(tb))
+		}
+	}
+}
+
+func TestRenderZeroRows(t *testing.T) {
+	cases := []struct {
+		name   string
+		innerH bool
+		want   string
+	}{
+		{"default sides", true, "+----+------+\n| id | name |\n+----+------+\n"},
+		{"inner horizontal border hidden", false, "+----+------+\n| id | name |\n+----+------+\n"},
+	}
+	for _, c := range cases {
+		tb := newTable(t, []string{"id", "name"})
+		tb.SetBorderSides(true, true, true, true, c.innerH, true)
+		if got := rendered(tb); got != c.want {
+			t.Errorf("%s:\ngot\n%s\nwant\n%s", c.name, got, c.want)
+		}
+	}
+
+	// with rows, the hidden inner horizontal border only removes the separator below the header
+	tb := newTable(t, []string{"id", "name"}, []string{"1", "a"})
+	tb.SetBorderSides(true, true, true, true, false, true)
+	if got, want := rendered(tb), "+----+------+\n| id | name |\n| 1  |  a   |\n+----+------+\n"; got != want {
+		t.Errorf("one row, inner horizontal border hidden:\ngot\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestRenderBorderless(t *testing.T) {
+	tb := newTable(t, []string{"id", "name", "city"},
+		[]string{"1", "Alice", "Paris"},
+		[]string{"22", "Bob", "北京"},
+		[]string{"333", "Christopher", "Rio\nde Janeiro"},
+	)
+	tb.Align("id", R)
+	tb.Align("name", L)
+	tb.CloseBorder()
+
+	golden(t, "borderless", rendered(tb))
+}
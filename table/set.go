@@ -3,6 +3,8 @@ package table
 import (
 	"fmt"
 	"github.com/liushuochen/gotable/cell"
+	"github.com/liushuochen/gotable/exception"
+	"strings"
 )
 
 type Set struct {
@@ -35,9 +37,14 @@ func (set *Set) Clear() {
 	set.base = make([]*cell.Column, 0)
 }
 
+// Add appends the element. It returns an *exception.EmptyColumnNameError if the element is empty or only holds
+// whitespace, and an error if the element already exists.
 func (set *Set) Add(element string) error {
+	if strings.TrimSpace(element) == "" {
+		return exception.EmptyColumnName(element)
+	}
 	if set.Exist(element) {
-		return fmt.Errorf("value %s has exit", element)
+		return existError(element)
 	}
 
 	newHeader := cell.CreateColumn(element)
@@ -45,6 +52,26 @@ func (set *Set) Add(element string) error {
 	return nil
 }
 
+// Rename changes the name of the element old to new. The element keeps its position and settings.
+func (set *Set) Rename(old, new string) error {
+	position := set.exist(old)
+	if position == -1 {
+		return fmt.Errorf("value %s has not exit", old)
+	}
+	if old == new {
+		return nil
+	}
+	if strings.TrimSpace(new) == "" {
+		return exception.EmptyColumnName(new)
+	}
+	if set.Exist(new) {
+		return existError(new)
+	}
+
+	set.base[position].SetName(new)
+	return nil
+}
+
 func (set *Set) Remove(element string) error {
 	position := set.exist(element)
 	if position == -1 {
@@ -77,3 +104,7 @@ func (set *Set) Equal(other *Set) bool {
 	}
 	return true
 }
+
+func existError(element string) error {
+	return fmt.Errorf("value %s has exit", element)
+}
@@ -0,0 +1,56 @@
+package table
+
+import (
+	"github.com/liushuochen/gotable/exception"
+	"strings"
+)
+
+// SQL dialects, used to quote identifiers in *Table.ToSQL
+const (
+	// StandardSQL quotes identifiers with double quotes, as PostgreSQL, SQLite and Oracle do.
+	StandardSQL = iota
+	// MySQL quotes identifiers with backticks.
+	MySQL
+)
+
+// ToSQL returns one INSERT statement per row, e.g. INSERT INTO "users" ("id", "name") VALUES ('1', 'Bob');.
+// Identifiers are quoted according to the dialect and values are single-quoted strings with embedded quotes doubled.
+// For MySQL, backslashes are escaped as well, since MySQL reads them as escape characters in string literals.
+// It returns an *exception.ColumnsLengthError if the table has no column.
+func (tb *Table) ToSQL(tableName string, dialect int) (string, error) {
+	tb.rLock()
+	defer tb.rUnlock()
+	if tb.Columns.Len() <= 0 {
+		return "", exception.ColumnsLength()
+	}
+
+	columns := make([]string, 0)
+	for _, col := range tb.Columns.base {
+		columns = append(columns, quoteIdentifier(col.Original(), dialect))
+	}
+	prefix := "INSERT INTO " + quoteIdentifier(tableName, dialect) + " (" + strings.Join(columns, ", ") + ") VALUES ("
+
+	builder := new(strings.Builder)
+	for _, row := range tb.Row {
+		values := make([]string, 0)
+		for _, col := range tb.Columns.base {
+			values = append(values, quoteValue(row[col.Original()].String(), dialect))
+		}
+		builder.WriteString(prefix + strings.Join(values, ", ") + ");\n")
+	}
+	return builder.String(), nil
+}
+
+func quoteValue(value string, dialect int) string {
+	if dialect == MySQL {
+		value = strings.ReplaceAll(value, `\`, `\\`)
+	}
+	return "'" + strings.ReplaceAll(value, "'", "''") + "'"
+}
+
+func quoteIdentifier(name string, dialect int) string {
+	if dialect == MySQL {
+		return "`" + strings.ReplaceAll(name, "`", "``") + "`"
+	}
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
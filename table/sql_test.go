@@ -0,0 +1,38 @@
+package table
+
+import "testing"
+
+func TestToSQLEscapesValues(t *testing.T) {
+	tb := newTable(t, []string{"id", "name"}, []string{"1", `x\`}, []string{"2", `it's`})
+
+	cases := []struct {
+		dialect int
+		want    string
+	}{
+		{StandardSQL, `INSERT INTO "users" ("id", "name") VALUES ('1', 'x\');` + "\n" +
+			`INSERT INTO "users" ("id", "name") VALUES ('2', 'it''s');` + "\n"},
+		{MySQL, "INSERT INTO `users` (`id`, `name`) VALUES ('1', 'x\\\\');\n" +
+			"INSERT INTO `users` (`id`, `name`) VALUES ('2', 'it''s');\n"},
+	}
+	for _, c := range cases {
+		got, err := tb.ToSQL("users", c.dialect)
+		if err != nil {
+			t.Fatalf("dialect %d: %v", c.dialect, err)
+		}
+		if got != c.want {
+			t.Errorf("dialect %d:\ngot  %q\nwant %q", c.dialect, got, c.want)
+		}
+	}
+}
+
+func TestToSQLMySQLBackslashBeforeQuote(t *testing.T) {
+	tb := newTable(t, []string{"v"}, []string{`\'); DROP TABLE users; --`})
+	got, err := tb.ToSQL("t", MySQL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "INSERT INTO `t` (`v`) VALUES ('\\\\''); DROP TABLE users; --');\n"
+	if got != want {
+		t.Errorf("got  %q\nwant %q", got, want)
+	}
+}
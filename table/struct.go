@@ -0,0 +1,131 @@
+package table
+
+import (
+	"fmt"
+	"github.com/liushuochen/gotable/exception"
+	"github.com/liushuochen/gotable/util"
+	"reflect"
+	"strconv"
+	"time"
+)
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// ScanRow sets the fields of the struct dest points to from the values of the row at index. The fields are mapped to
+// the columns like in CreateByStruct, by struct tag: gotable or by field name, and the fields without a column are
+// left untouched. The values are converted to the type of the fields: strings, booleans, integers, floating-point
+// numbers, byte slices, time.Time in RFC 3339 format and pointers to them. An empty value sets the zero value.
+// Return error types:
+//   - *exception.NotAStructPointerError: It returned if dest is not a pointer to struct.
+//   - *exception.RowIndexOutOfRangeError: It returned if index is negative or not less than the length of the table.
+//   - *exception.InvalidCellValueError: It returned if a value can not be converted to the type of its field.
+//   - *exception.DuplicateStructColumnError: It returned if several fields are mapped to the same column.
+func (tb *Table) ScanRow(index int, dest interface{}) error {
+	tb.rLock()
+	defer tb.rUnlock()
+	value := reflect.ValueOf(dest)
+	if value.Kind() != reflect.Ptr || value.IsNil() || value.Elem().Kind() != reflect.Struct {
+		return exception.NotAStructPointer(dest)
+	}
+	if index < 0 || index >= len(tb.Row) {
+		return exception.RowIndexOutOfRange(index, len(tb.Row))
+	}
+
+	columns, err := util.StructColumns(value.Elem().Type())
+	if err != nil {
+		return err
+	}
+	row := tb.Row[index]
+	for _, column := range columns {
+		c, ok := row[column.Name]
+		if !ok {
+			continue
+		}
+		field, ok := settableField(value.Elem(), column.Index)
+		if !ok {
+			continue
+		}
+		err = scan(field, c.String())
+		if err != nil {
+			return exception.InvalidCellValue(column.Name, c.String(), err)
+		}
+	}
+	return nil
+}
+
+// settableField returns the field of the struct value at index, allocating the nil embedded struct pointers on the
+// way. It returns false if the field can not be set.
+func settableField(value reflect.Value, index []int) (reflect.Value, bool) {
+	for i, x := range index {
+		if i > 0 && value.Kind() == reflect.Ptr {
+			if value.IsNil() {
+				if !value.CanSet() {
+					return reflect.Value{}, false
+				}
+				value.Set(reflect.New(value.Type().Elem()))
+			}
+			value = value.Elem()
+		}
+		value = value.Field(x)
+	}
+	return value, value.CanSet()
+}
+
+// scan sets field to s converted to the type of field.
+func scan(field reflect.Value, s string) error {
+	if s == "" {
+		field.Set(reflect.Zero(field.Type()))
+		return nil
+	}
+	if field.Kind() == reflect.Ptr {
+		if field.IsNil() {
+			field.Set(reflect.New(field.Type().Elem()))
+		}
+		return scan(field.Elem(), s)
+	}
+	if field.Type() == timeType {
+		t, err := time.Parse(time.RFC3339, s)
+		if err != nil {
+			return err
+		}
+		field.Set(reflect.ValueOf(t))
+		return nil
+	}
+
+	switch field.Kind() {
+	case reflect.String:
+		field.SetString(s)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return err
+		}
+		field.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(s, 10, field.Type().Bits())
+		if err != nil {
+			return err
+		}
+		field.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(s, 10, field.Type().Bits())
+		if err != nil {
+			return err
+		}
+		field.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(s, field.Type().Bits())
+		if err != nil {
+			return err
+		}
+		field.SetFloat(f)
+	case reflect.Slice:
+		if field.Type().Elem().Kind() != reflect.Uint8 {
+			return fmt.Errorf("unsupported field type %s", field.Type())
+		}
+		field.SetBytes([]byte(s))
+	default:
+		return fmt.Errorf("unsupported field type %s", field.Type())
+	}
+	return nil
+}
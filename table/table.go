@@ -1,14 +1,22 @@
 package table
 
 import (
-	"encoding/csv"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"github.com/liushuochen/gotable/cell"
+	"github.com/liushuochen/gotable/color"
 	"github.com/liushuochen/gotable/exception"
 	"github.com/liushuochen/gotable/util"
+	"github.com/liushuochen/gotable/xlsx"
+	"io"
+	"io/ioutil"
 	"os"
 	"strings"
+	"sync"
+	"unicode"
 )
 
 const (
@@ -19,9 +27,43 @@ const (
 )
 
 type Table struct {
-	Columns *Set
-	Row  	[]map[string]cell.Cell
-	border	bool
+	Columns 			*Set
+	Row  				[]map[string]cell.Cell
+	border				bool
+	maxRowHeight		int
+	trimTrailingSpace	bool
+	rowNumbers			bool
+	nullText			string
+	padding				int
+	sides				borderSides
+	title				string
+	fitWidth			int
+	autoFit				bool
+	leftBias			bool
+	colorMode			int
+	compact				bool
+	headerFormatter		func(string) string
+	rowColorFunc		func(row map[string]string) (display, fount, background int, ok bool)
+	headerRepeat		int
+	tabWidth			int
+	trimCells			bool
+	strictRows			bool
+	maxRows				int
+	newRows				int
+	printedWidths		[]int
+	autoFormatted		map[*cell.Column]columnFormat
+	err					error
+	mu					*sync.RWMutex
+}
+
+// borderSides holds the parts of the border that are printed when the border is open.
+type borderSides struct {
+	top		bool
+	bottom	bool
+	left	bool
+	right	bool
+	innerH	bool
+	innerV	bool
 }
 
 func CreateTable(set *Set) *Table {
@@ -29,28 +71,133 @@ func CreateTable(set *Set) *Table {
 		Columns: set,
 		Row: make([]map[string]cell.Cell, 0),
 		border: true,
+		padding: 1,
+		tabWidth: 4,
+		sides: borderSides{true, true, true, true, true, true},
+	}
+}
+
+// emptyCopy returns a table without rows that has a copy of the columns and the settings of tb.
+func (tb *Table) emptyCopy() *Table {
+	set := &Set{base: make([]*cell.Column, 0)}
+	autoFormatted := make(map[*cell.Column]columnFormat)
+	for _, col := range tb.Columns.base {
+		clone := col.Clone()
+		set.base = append(set.base, clone)
+		if format, ok := tb.autoFormatted[col]; ok {
+			autoFormatted[clone] = format
+		}
 	}
+
+	copied := *tb
+	copied.Columns = set
+	copied.autoFormatted = autoFormatted
+	copied.err = nil
+	copied.Row = make([]map[string]cell.Cell, 0)
+	copied.newRows = 0
+	copied.printedWidths = nil
+	if tb.mu != nil {
+		copied.mu = new(sync.RWMutex)
+	}
+	return &copied
+}
+
+// copyRow returns a copy of a row.
+func copyRow(row map[string]cell.Cell) map[string]cell.Cell {
+	copied := make(map[string]cell.Cell)
+	for key, value := range row {
+		copied[key] = value
+	}
+	return copied
 }
 
 // Clear the table. The table is cleared of all data.
 func (tb *Table) Clear() {
+	tb.lock()
+	defer tb.unlock()
 	tb.Columns.Clear()
 	tb.Row = make([]map[string]cell.Cell, 0)
+	tb.rowsChanged()
+}
+
+// ClearRows removes all the rows of the table. The columns and their defaults, alignment and colors are kept.
+func (tb *Table) ClearRows() {
+	tb.lock()
+	defer tb.unlock()
+	tb.Row = make([]map[string]cell.Cell, 0)
+	tb.rowsChanged()
 }
 
 func (tb *Table) AddColumn(column string) error {
+	tb.lock()
+	defer tb.unlock()
+	return tb.addColumn(column, "")
+}
+
+// AddColumnWithDefault adds a column whose default value is defaultValue. The existing rows get the default value in
+// the new column.
+func (tb *Table) AddColumnWithDefault(column, defaultValue string) error {
+	tb.lock()
+	defer tb.unlock()
+	return tb.addColumn(column, defaultValue)
+}
+
+func (tb *Table) addColumn(column, defaultValue string) error {
 	err := tb.Columns.Add(column)
 	if err != nil {
 		return err
 	}
+	tb.Columns.Get(column).SetDefault(defaultValue)
 
 	// modify exist value, add new column.
 	for _, row := range tb.Row {
-		row[column] = cell.CreateEmptyData()
+		row[column] = cell.CreateData(defaultValue)
+	}
+	return nil
+}
+
+// AddComputedColumn adds a column whose value is computed by fn from the values of the row, e.g. a total from a price
+// and a quantity. The value is computed for the existing rows and for every row added later by AddRow, AddRows or
+// InsertRow, overriding any value given for the column. Computed columns are computed in column order, so fn sees the
+// values of the computed columns on their left.
+// It returns an error if the column already exists, and an *exception.EmptyColumnNameError if the name is empty.
+func (tb *Table) AddComputedColumn(name string, fn func(row map[string]string) string) error {
+	tb.lock()
+	defer tb.unlock()
+	err := tb.addColumn(name, "")
+	if err != nil {
+		return err
+	}
+	tb.Columns.Get(name).SetCompute(fn)
+
+	for _, row := range tb.Row {
+		row[name] = cell.CreateData(fn(rowValues(row)))
 	}
 	return nil
 }
 
+// compute sets the values of the computed columns of a row.
+func (tb *Table) compute(row map[string]string) {
+	for _, col := range tb.Columns.base {
+		if col.Compute() == nil {
+			continue
+		}
+
+		values := make(map[string]string)
+		for k, v := range row {
+			values[k] = v
+		}
+		row[col.Original()] = col.Compute()(values)
+	}
+}
+
+// SetStrictRows controls whether a map row given to AddRow must hold every column. When enabled, a missing column is
+// an error instead of being set to its default value, which helps to catch data entry bugs. Computed columns may
+// always be missing, and slice rows are not affected. It is disabled by default.
+func (tb *Table) SetStrictRows(enable bool) {
+	tb.strictRows = enable
+}
+
 func (tb *Table) SetDefault(h string, defaultValue string) {
 	for _, head := range tb.Columns.base {
 		if head.Original() == h {
@@ -81,7 +228,8 @@ func (tb *Table) GetDefaults() map[string]string {
 	return defaults
 }
 
-// AddRow method support Map and Slice argument.
+// AddRow method support Map and Slice argument, of strings or of any values. Values that are not strings are converted
+// with fmt.Sprint, and nil values are converted to empty strings.
 // For Map argument, you must put the data from each row into a Map and use column-data as key-value pairs. If the Map
 //   does not contain a column, the table sets it to the default value. If the Map contains a column that does not
 //   exist, the AddRow method returns an error.
@@ -93,21 +241,97 @@ func (tb *Table) GetDefaults() map[string]string {
 //       different from the length of column.
 //   - *exception.ColumnDoNotExistError: It returned if the argument is type of the Map but contains a nonexistent
 //       column as a key.
+//   - *exception.InvalidCellValueError: It returned if a value, defaults included, is rejected by the type of its
+//       column.
+//   - *exception.ColumnsMismatchError: It returned if strict rows are enabled and the argument is type of the Map but
+//       misses some columns, which are listed by its Missing method.
 func (tb *Table) AddRow(row interface{}) error {
+	tb.lock()
+	defer tb.unlock()
+	rowMap, err := tb.toRowMap(row)
+	if err != nil {
+		return err
+	}
+	tb.Row = append(tb.Row, toRow(rowMap))
+	tb.newRows++
+	tb.evict()
+	return nil
+}
+
+// InsertRow inserts a row at index, shifting the rows from index down. The row is given as to AddRow, so the
+// defaults and the column types apply. An index equal to the length of the table appends the row.
+// Return error types:
+//   - *exception.RowIndexOutOfRangeError: It returned if index is negative or greater than the length of the table.
+//   - The errors of AddRow.
+func (tb *Table) InsertRow(index int, row interface{}) error {
+	tb.lock()
+	defer tb.unlock()
+	if index < 0 || index > len(tb.Row) {
+		return exception.RowIndexOutOfRange(index, len(tb.Row))
+	}
+
+	rowMap, err := tb.toRowMap(row)
+	if err != nil {
+		return err
+	}
+	if index == len(tb.Row) {
+		tb.newRows++
+	} else {
+		tb.rowsChanged()
+	}
+	tb.Row = append(tb.Row, nil)
+	copy(tb.Row[index+1:], tb.Row[index:])
+	tb.Row[index] = toRow(rowMap)
+	tb.evict()
+	return nil
+}
+
+// toRowMap converts a row given to AddRow to the values of every column, defaults and computed columns included,
+// and validates them.
+func (tb *Table) toRowMap(row interface{}) (map[string]string, error) {
+	var rowMap map[string]string
+	var err error
 	switch v := row.(type) {
 	case []string:
-		return tb.addRowFromSlice(v)
+		rowMap, err = tb.rowFromSlice(v)
 	case map[string]string:
-		return tb.addRowFromMap(v)
+		rowMap, err = tb.rowFromMap(v)
+	case []interface{}:
+		values := make([]string, 0, len(v))
+		for _, value := range v {
+			values = append(values, sprint(value))
+		}
+		rowMap, err = tb.rowFromSlice(values)
+	case map[string]interface{}:
+		values := make(map[string]string)
+		for key, value := range v {
+			values[key] = sprint(value)
+		}
+		rowMap, err = tb.rowFromMap(values)
 	default:
-		return exception.UnsupportedRowType(v)
+		return nil, exception.UnsupportedRowType(v)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	tb.compute(rowMap)
+	return rowMap, nil
+}
+
+// sprint converts a value of a []interface{} or map[string]interface{} row to a string. A nil value, e.g. a JSON
+// null or a NULL column of a database, is converted to an empty string.
+func sprint(value interface{}) string {
+	if value == nil {
+		return ""
 	}
+	return fmt.Sprint(value)
 }
 
-func (tb *Table) addRowFromSlice(row []string) error {
+func (tb *Table) rowFromSlice(row []string) (map[string]string, error) {
 	rowLength := len(row)
 	if rowLength != tb.Columns.Len() {
-		return exception.RowLengthNotEqualColumns(rowLength, tb.Columns.Len())
+		return nil, exception.RowLengthNotEqualColumns(rowLength, tb.Columns.Len())
 	}
 
 	rowMap := make(map[string]string, 0)
@@ -119,14 +343,17 @@ func (tb *Table) addRowFromSlice(row []string) error {
 		}
 	}
 
-	tb.Row = append(tb.Row, toRow(rowMap))
-	return nil
+	err := tb.validate(rowMap)
+	if err != nil {
+		return nil, err
+	}
+	return rowMap, nil
 }
 
-func (tb *Table) addRowFromMap(row map[string]string) error {
+func (tb *Table) rowFromMap(row map[string]string) (map[string]string, error) {
 	for key := range row {
 		if !tb.Columns.Exist(key) {
-			return exception.ColumnDoNotExist(key)
+			return nil, exception.ColumnDoNotExist(key)
 		}
 
 		// add row by const `DEFAULT`
@@ -135,6 +362,18 @@ func (tb *Table) addRowFromMap(row map[string]string) error {
 		}
 	}
 
+	if tb.strictRows {
+		missing := make([]string, 0)
+		for _, col := range tb.Columns.base {
+			if _, ok := row[col.Original()]; !ok && col.Compute() == nil {
+				missing = append(missing, col.Original())
+			}
+		}
+		if len(missing) > 0 {
+			return nil, exception.ColumnsMismatch(missing, []string{})
+		}
+	}
+
 	// Add default value
 	for _, col := range tb.Columns.base {
 		_, ok := row[col.Original()]
@@ -143,8 +382,11 @@ func (tb *Table) addRowFromMap(row map[string]string) error {
 		}
 	}
 
-	tb.Row = append(tb.Row, toRow(row))
-	return nil
+	err := tb.validate(row)
+	if err != nil {
+		return nil, err
+	}
+	return row, nil
 }
 
 func (tb *Table) AddRows(rows []map[string]string) []map[string]string {
@@ -158,72 +400,231 @@ func (tb *Table) AddRows(rows []map[string]string) []map[string]string {
 	return failure
 }
 
+// AddRowsStrict adds the rows like AddRow, but all or nothing: it stops at the first rejected row and adds none of
+// the rows. It returns an *exception.InvalidRowError holding the index of the rejected row, and the error of AddRow
+// that can be retrieved with errors.Unwrap.
+func (tb *Table) AddRowsStrict(rows []map[string]string) error {
+	tb.lock()
+	defer tb.unlock()
+	added := make([]map[string]cell.Cell, 0, len(rows))
+	for index, row := range rows {
+		rowMap, err := tb.toRowMap(row)
+		if err != nil {
+			return exception.InvalidRow(index, err)
+		}
+		added = append(added, toRow(rowMap))
+	}
+
+	tb.Row = append(tb.Row, added...)
+	tb.newRows += len(added)
+	tb.evict()
+	return nil
+}
+
+// SetMaxRows bounds the table to the n most recent rows, like a ring buffer: when a row is added beyond the limit, the
+// oldest rows are removed. Rows beyond the limit are removed at once. A value less than or equal to 0 means unbounded,
+// which is the default.
+func (tb *Table) SetMaxRows(n int) {
+	tb.lock()
+	defer tb.unlock()
+	tb.maxRows = n
+	tb.evict()
+}
+
+// evict removes the oldest rows beyond the max rows.
+func (tb *Table) evict() {
+	if tb.maxRows > 0 && len(tb.Row) > tb.maxRows {
+		tb.Row = tb.Row[len(tb.Row)-tb.maxRows:]
+	}
+}
+
 // PrintTable method used to print table data in STDOUT
 func (tb *Table) PrintTable() {
-	columnMaxLength := make(map[string]int)
-	tag := make(map[string]cell.Cell)
-	taga := make([]map[string]cell.Cell, 0)
-	for _, h := range tb.Columns.base {
-		columnMaxLength[h.Original()] = h.Length()
-		tag[h.String()] = cell.CreateData("-")
+	tb.rLock()
+	defer tb.rUnlock()
+	for _, line := range tb.linesFor(os.Stdout, tb.renderWidth(0, len(tb.Row), false, tb.stdoutWidth())) {
+		fmt.Println(line)
 	}
+}
 
-	for _, data := range tb.Row {
-		for _, h := range tb.Columns.base {
-			maxLength := max(h.Length(), data[h.Original()].Length())
-			maxLength = max(maxLength, columnMaxLength[h.Original()])
-			columnMaxLength[h.Original()] = maxLength
+// PrintNewRows prints in STDOUT the rows added since its last call, without the header, so that a table that only
+// grows, e.g. while tailing a log, can be followed without reprinting it. The first call prints the whole table. The
+// bottom border is never printed, as the table stays open for the next rows. Rows evicted by SetMaxRows are not
+// printed again, and new rows that were evicted before being printed are skipped. When the column widths changed, or
+// the rows were cleared, reordered or removed other than by eviction since the last call, the whole table is printed
+// again. Changes made directly to the Row field are not tracked.
+func (tb *Table) PrintNewRows() {
+	tb.lock()
+	defer tb.unlock()
+	start := max(len(tb.Row)-tb.newRows, 0)
+	lines, widths := tb.bodyLines(start, len(tb.Row), tb.stdoutWidth())
+	if tb.printedWidths == nil || !equalWidths(widths, tb.printedWidths) {
+		lines = tb.renderWidth(0, len(tb.Row), false, tb.stdoutWidth())
+		if tb.border && tb.sides.bottom && (len(tb.Row) > 0 || !tb.sides.innerH) {
+			lines = lines[:len(lines)-1]
 		}
 	}
 
-	// print first line
-	taga = append(taga, tag)
-	if tb.border {
-		tb.printGroup(taga, columnMaxLength)
+	for _, line := range tb.linesFor(os.Stdout, lines) {
+		fmt.Println(line)
 	}
+	tb.newRows = 0
+	tb.printedWidths = widths
+}
 
-	// print table head
-	icon := "|"
-	if !tb.border { icon = " " }
-	for index, head := range tb.Columns.base {
-		itemLen := columnMaxLength[head.Original()]
-        if tb.border { itemLen += 2 }
-		s := ""
-		switch head.Align() {
-		case R:
-			s, _ = right(head, itemLen, " ")
-		case L:
-			s, _ = left(head, itemLen, " ")
-		default:
-			s, _ = center(head, itemLen, " ")
+// rowsChanged makes the next call of PrintNewRows print the whole table, after rows were changed other than by being
+// appended or evicted.
+func (tb *Table) rowsChanged() {
+	tb.newRows = 0
+	tb.printedWidths = nil
+}
+
+func equalWidths(x, y []int) bool {
+	if len(x) != len(y) {
+		return false
+	}
+	for index := range x {
+		if x[index] != y[index] {
+			return false
 		}
-		if index == 0 {
-			s = icon + s + icon
-		} else {
-			s = "" + s + icon
+	}
+	return true
+}
+
+// PrintHead prints the header and the first n rows in STDOUT, followed by a "... and N more rows" line when the table
+// has more rows. Column widths are computed from the printed rows only, which keeps the preview compact.
+func (tb *Table) PrintHead(n int) {
+	tb.rLock()
+	defer tb.rUnlock()
+	if n < 0 {
+		n = 0
+	}
+	if n > len(tb.Row) {
+		n = len(tb.Row)
+	}
+
+	for _, line := range tb.linesFor(os.Stdout, tb.renderWidth(0, n, false, tb.stdoutWidth())) {
+		fmt.Println(line)
+	}
+	if more := len(tb.Row) - n; more > 0 {
+		fmt.Printf("... and %d more rows\n", more)
+	}
+}
+
+// RenderWithLineHook writes the lines PrintTable prints to w, passing each line through hook first. The hook
+// receives the index of the line, starting at 0, and returns the line to write; returning it unchanged is a no-op.
+// A nil hook writes the lines as they are. The colors of the table are only written when w is a terminal.
+func (tb *Table) RenderWithLineHook(w io.Writer, hook func(lineIndex int, line string) string) error {
+	tb.rLock()
+	defer tb.rUnlock()
+	for index, line := range tb.linesFor(w, tb.render(0, len(tb.Row), false)) {
+		if hook != nil {
+			line = hook(index, line)
 		}
+		_, err := fmt.Fprintln(w, line)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
 
-		fmt.Print(s)
+// PrintPage prints the rows of a page in STDOUT, with the header and the border. Pages are numbered from 1 and hold
+// pageSize rows. Column widths are computed from the whole table, so all the pages line up.
+// Return error types:
+//   - *exception.PageOutOfRangeError: It returned when pageSize is not positive or pageNumber does not exist.
+func (tb *Table) PrintPage(pageSize, pageNumber int) error {
+	tb.rLock()
+	defer tb.rUnlock()
+	count := tb.pageCount(pageSize)
+	if pageSize <= 0 || pageNumber < 1 || pageNumber > count {
+		return exception.PageOutOfRange(pageNumber, count)
 	}
 
-	if tb.border {
-		fmt.Println()
+	start := (pageNumber - 1) * pageSize
+	end := start + pageSize
+	if end > len(tb.Row) {
+		end = len(tb.Row)
 	}
+	for _, line := range tb.linesFor(os.Stdout, tb.renderWidth(start, end, true, tb.stdoutWidth())) {
+		fmt.Println(line)
+	}
+	return nil
+}
 
-	// print value
-	tableValue := taga
-	if !tb.Empty() {
-		for _, row := range tb.Row {
-			value := make(map[string]cell.Cell)
-			for key := range row {
-				col := tb.Columns.Get(key)
-				value[col.String()] = row[key]
+// PrintVertical prints the table in STDOUT one record at a time, like the \G terminator of MySQL: every row starts
+// with a "*** row N ***" line and is followed by a "column: value" line per column. The column names are right
+// aligned to the longest name, and colors are not printed. The values are printed as GetValues returns them, without
+// the null text, the column formats and the list bullets of PrintTable.
+func (tb *Table) PrintVertical() {
+	tb.rLock()
+	defer tb.rUnlock()
+	width := 0
+	for _, col := range tb.Columns.base {
+		width = max(width, util.Length(col.Original()))
+	}
+
+	for index, row := range tb.Row {
+		fmt.Printf("*** row %d ***\n", index+1)
+		for _, col := range tb.Columns.base {
+			name := col.Original()
+			lines := strings.Split(row[name].String(), "\n")
+			fmt.Printf("%s%s: %s\n", block(width-util.Length(name)), name, lines[0])
+			for _, line := range lines[1:] {
+				fmt.Printf("%s  %s\n", block(width), line)
 			}
-			tableValue = append(tableValue, value)
 		}
-		tableValue = append(tableValue, tag)
 	}
-	tb.printGroup(tableValue, columnMaxLength)
+}
+
+// PageCount returns the number of pages of pageSize rows. An empty table has one empty page, and a pageSize that is
+// not positive has no page.
+func (tb *Table) PageCount(pageSize int) int {
+	tb.rLock()
+	defer tb.rUnlock()
+	return tb.pageCount(pageSize)
+}
+
+func (tb *Table) pageCount(pageSize int) int {
+	if pageSize <= 0 {
+		return 0
+	}
+	if len(tb.Row) == 0 {
+		return 1
+	}
+	return (len(tb.Row) + pageSize - 1) / pageSize
+}
+
+// Width returns the number of characters of the widest line PrintTable prints, borders included. Chinese characters
+// count as two characters, as they do when the table is printed.
+func (tb *Table) Width() int {
+	tb.rLock()
+	defer tb.rUnlock()
+	width := 0
+	for _, line := range tb.render(0, len(tb.Row), false) {
+		width = max(width, util.Length(util.StripColor(line)))
+	}
+	return width
+}
+
+// ColumnWidth returns the number of characters of the widest printed value of the column, header included, without
+// padding. Values are measured as PrintTable prints them: formatted, with the longest line of a multi-line value, and
+// Chinese characters counting as two characters.
+// It returns an *exception.ColumnDoNotExistError if the column does not exist.
+func (tb *Table) ColumnWidth(column string) (int, error) {
+	tb.rLock()
+	defer tb.rUnlock()
+	if !tb.Columns.Exist(column) {
+		return 0, exception.ColumnDoNotExist(column)
+	}
+	return tb.columnMaxLength(tb.Row)[column], nil
+}
+
+// Height returns the number of lines PrintTable prints, multi-line rows and borders included.
+func (tb *Table) Height() int {
+	tb.rLock()
+	defer tb.rUnlock()
+	return len(tb.render(0, len(tb.Row), false))
 }
 
 func (tb *Table) Empty() bool {
@@ -231,10 +632,28 @@ func (tb *Table) Empty() bool {
 }
 
 func (tb *Table) Length() int {
+	tb.rLock()
+	defer tb.rUnlock()
 	return len(tb.Row)
 }
 
+// ColumnCount returns the number of columns of the table.
+func (tb *Table) ColumnCount() int {
+	tb.rLock()
+	defer tb.rUnlock()
+	return tb.Columns.Len()
+}
+
+// Size returns the number of rows and the number of columns of the table.
+func (tb *Table) Size() (rows, cols int) {
+	tb.rLock()
+	defer tb.rUnlock()
+	return len(tb.Row), tb.Columns.Len()
+}
+
 func (tb *Table) GetColumns() []string {
+	tb.rLock()
+	defer tb.rUnlock()
 	columns := make([]string, 0)
 	for _, col := range tb.Columns.base {
 		columns = append(columns, col.Original())
@@ -243,6 +662,8 @@ func (tb *Table) GetColumns() []string {
 }
 
 func (tb *Table) GetValues() []map[string]string {
+	tb.rLock()
+	defer tb.rUnlock()
 	values := make([]map[string]string, 0)
 	for _, value := range tb.Row {
 		ms := make(map[string]string)
@@ -254,26 +675,168 @@ func (tb *Table) GetValues() []map[string]string {
 	return values
 }
 
-func (tb *Table) Exist(value map[string]string) bool {
+// Column returns the values of the column in row order.
+// It returns an *exception.ColumnDoNotExistError if the column does not exist.
+func (tb *Table) Column(name string) ([]string, error) {
+	tb.rLock()
+	defer tb.rUnlock()
+	if !tb.Columns.Exist(name) {
+		return nil, exception.ColumnDoNotExist(name)
+	}
+
+	values := make([]string, 0, len(tb.Row))
 	for _, row := range tb.Row {
-		exist := true
-		for key := range value {
-			v, ok := row[key]
-			if !ok || v.String() != value[key] {
-				exist = false
-				break
-			}
+		values = append(values, row[name].String())
+	}
+	return values, nil
+}
+
+// ForEach calls fn for each row in order, with the index of the row and a copy of its values. It stops at the first
+// error returned by fn and returns it. Unlike GetValues, only one row is copied at a time.
+func (tb *Table) ForEach(fn func(index int, row map[string]string) error) error {
+	for index := 0; ; index++ {
+		tb.rLock()
+		if index >= len(tb.Row) {
+			tb.rUnlock()
+			break
+		}
+		values := make(map[string]string)
+		for k, v := range tb.Row[index] {
+			values[k] = v.String()
+		}
+		tb.rUnlock()
+
+		err := fn(index, values)
+		if err != nil {
+			return err
 		}
-		if exist { return exist }
+	}
+	return nil
+}
+
+func (tb *Table) Exist(value map[string]string) bool {
+	tb.rLock()
+	defer tb.rUnlock()
+	for _, row := range tb.Row {
+		if match(row, value) { return true }
 	}
 	return false
 }
 
+// Count returns the number of rows that match all the column-value pairs of value. An empty value matches every row.
+func (tb *Table) Count(value map[string]string) int {
+	tb.rLock()
+	defer tb.rUnlock()
+	count := 0
+	for _, row := range tb.Row {
+		if match(row, value) {
+			count++
+		}
+	}
+	return count
+}
+
+// IndexOf returns the index of the first row that matches all the column-value pairs of value, or -1 if no row
+// matches. The matching is the same as in Exist.
+func (tb *Table) IndexOf(value map[string]string) int {
+	tb.rLock()
+	defer tb.rUnlock()
+	for index, row := range tb.Row {
+		if match(row, value) {
+			return index
+		}
+	}
+	return -1
+}
+
+// match reports whether the row contains all the column-value pairs of value.
+func match(row map[string]cell.Cell, value map[string]string) bool {
+	for key := range value {
+		v, ok := row[key]
+		if !ok || v.String() != value[key] {
+			return false
+		}
+	}
+	return true
+}
+
+// Distinct removes the rows whose values are identical to the values of an earlier row, keeping the first
+// occurrence and the order of the remaining rows. It returns the number of removed rows.
+func (tb *Table) Distinct() int {
+	tb.lock()
+	defer tb.unlock()
+	seen := make(map[string]bool)
+	rows := make([]map[string]cell.Cell, 0)
+	for _, row := range tb.Row {
+		key := tb.rowKey(row)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		rows = append(rows, row)
+	}
+
+	removed := len(tb.Row) - len(rows)
+	tb.Row = rows
+	if removed > 0 {
+		tb.rowsChanged()
+	}
+	return removed
+}
+
+// rowKey returns a string that identifies the values of a row, in column order.
+func (tb *Table) rowKey(row map[string]cell.Cell) string {
+	values := make([]string, 0)
+	for _, col := range tb.Columns.base {
+		values = append(values, row[col.Original()].String())
+	}
+	key, _ := json.Marshal(values)
+	return string(key)
+}
+
+// Hash returns the SHA-256 hex digest of the column names and the row values, in column and row order. It changes
+// when a column or a row is added, removed or moved, or a value changes, and does not depend on the settings of the
+// table.
+func (tb *Table) Hash() string {
+	tb.rLock()
+	defer tb.rUnlock()
+	columns := make([]string, 0)
+	for _, col := range tb.Columns.base {
+		columns = append(columns, col.Original())
+	}
+	header, _ := json.Marshal(columns)
+
+	h := sha256.New()
+	h.Write(header)
+	for _, row := range tb.Row {
+		h.Write([]byte("\n" + tb.rowKey(row)))
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
 func (tb *Table) json(indent int) ([]byte, error) {
-	data := make([]map[string]string, 0)
+	return tb.jsonWithOptions(indent, new(jsonOptions))
+}
+
+func (tb *Table) jsonWithOptions(indent int, o *jsonOptions) ([]byte, error) {
+	tb.rLock()
+	defer tb.rUnlock()
+	data := make([]interface{}, 0)
 	for _, row := range tb.Row {
+		if o.orderedKeys {
+			object, err := tb.orderedJSON(row, o.omitEmpty)
+			if err != nil {
+				return nil, err
+			}
+			data = append(data, json.RawMessage(object))
+			continue
+		}
+
 		element := make(map[string]string)
 		for col, value := range row {
+			if o.omitEmpty && value.String() == "" {
+				continue
+			}
 			element[col] = value.String()
 		}
 		data = append(data, element)
@@ -298,6 +861,74 @@ func (tb *Table) Json(indent int) (string, error) {
 	return string(bytes), nil
 }
 
+// WriteJSON writes the same JSON as the Json method to w.
+func (tb *Table) WriteJSON(w io.Writer, indent int) error {
+	bytes, err := tb.json(indent)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(bytes)
+	return err
+}
+
+// JSONL returns the rows as newline-delimited JSON: one compact JSON object per line, whose keys follow the column
+// order.
+func (tb *Table) JSONL() (string, error) {
+	tb.rLock()
+	defer tb.rUnlock()
+	builder := new(strings.Builder)
+	for _, row := range tb.Row {
+		object, err := tb.orderedJSON(row, false)
+		if err != nil {
+			return "", err
+		}
+		builder.Write(object)
+		builder.WriteString("\n")
+	}
+	return builder.String(), nil
+}
+
+// ToJSONLFile saves the rows to a newline-delimited JSON file.
+func (tb *Table) ToJSONLFile(path string) error {
+	if !util.IsJSONLFile(path) {
+		return exception.NotARegularJSONLFile(path)
+	}
+
+	content, err := tb.JSONL()
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, []byte(content), 0666)
+}
+
+// orderedJSON returns a row as a compact JSON object whose keys follow the column order. Empty values are left out
+// when omitEmpty is true.
+func (tb *Table) orderedJSON(row map[string]cell.Cell, omitEmpty bool) ([]byte, error) {
+	buffer := new(bytes.Buffer)
+	buffer.WriteString("{")
+	for _, col := range tb.Columns.base {
+		if omitEmpty && row[col.Original()].String() == "" {
+			continue
+		}
+		key, err := json.Marshal(col.Original())
+		if err != nil {
+			return nil, err
+		}
+		value, err := json.Marshal(row[col.Original()].String())
+		if err != nil {
+			return nil, err
+		}
+		if buffer.Len() > 1 {
+			buffer.WriteString(",")
+		}
+		buffer.Write(key)
+		buffer.WriteString(":")
+		buffer.Write(value)
+	}
+	buffer.WriteString("}")
+	return buffer.Bytes(), nil
+}
+
 func (tb *Table) CloseBorder() {
 	tb.border = false
 }
@@ -306,6 +937,38 @@ func (tb *Table) OpenBorder() {
 	tb.border = true
 }
 
+// HasBorder reports whether the border is open.
+func (tb *Table) HasBorder() bool {
+	return tb.border
+}
+
+// SetFillChar sets the character the values of the column are padded with when they are aligned, e.g. '.' for
+// dotted leaders. The header, the cell padding and the border lines still use spaces and "-". The character must be
+// printable and one column wide, otherwise an error is returned.
+// It returns an *exception.ColumnDoNotExistError if the column does not exist.
+func (tb *Table) SetFillChar(column string, ch rune) error {
+	col := tb.Columns.Get(column)
+	if col == nil {
+		return exception.ColumnDoNotExist(column)
+	}
+	if !unicode.IsPrint(ch) || util.Length(string(ch)) != 1 {
+		return fmt.Errorf("fill character %q must be printable and one column wide", ch)
+	}
+	col.SetFillChar(ch)
+	return nil
+}
+
+// SetCenterBias chooses the side that gets the extra space when a centered value can not be exactly centered: R,
+// the default, puts it on the right and L on the left. Other values are ignored.
+func (tb *Table) SetCenterBias(bias int) {
+	switch bias {
+	case L:
+		tb.leftBias = true
+	case R:
+		tb.leftBias = false
+	}
+}
+
 func (tb *Table) Align(column string, mode int) {
 	for _, h := range tb.Columns.base {
 		if h.Original() == column {
@@ -315,6 +978,121 @@ func (tb *Table) Align(column string, mode int) {
 	}
 }
 
+// SetCellAlign sets the alignment of a single cell, which PrintTable uses instead of the alignment of its column,
+// e.g. to align a summary cell right in a left aligned column. The cell keeps its alignment until its value changes.
+// Return error types:
+//   - *exception.RowIndexOutOfRangeError: It returned if row is negative or not less than the length of the table.
+//   - *exception.ColumnDoNotExistError: It returned if the column does not exist.
+func (tb *Table) SetCellAlign(row int, column string, mode int) error {
+	tb.lock()
+	defer tb.unlock()
+	if row < 0 || row >= len(tb.Row) {
+		return exception.RowIndexOutOfRange(row, len(tb.Row))
+	}
+	if !tb.Columns.Exist(column) {
+		return exception.ColumnDoNotExist(column)
+	}
+
+	// the cell is replaced, as it may be shared with a copy of the row
+	data := cell.CreateData(tb.Row[row][column].String())
+	data.SetAlign(mode)
+	tb.Row[row][column] = data
+	return nil
+}
+
+// SetColumnDirection sets whether the values of the column are written right to left, e.g. in Arabic or Hebrew. In a
+// right-to-left column, the L and R alignments are mirrored when the table is printed, so that L aligns the values
+// on the side they start from. The display width of the values does not count combining marks, so that the borders
+// line up. It returns an *exception.ColumnDoNotExistError if the column does not exist.
+func (tb *Table) SetColumnDirection(column string, rtl bool) error {
+	col := tb.Columns.Get(column)
+	if col == nil {
+		return exception.ColumnDoNotExist(column)
+	}
+	col.SetRTL(rtl)
+	return nil
+}
+
+// AlignAll sets the alignment of every column.
+func (tb *Table) AlignAll(mode int) {
+	for _, h := range tb.Columns.base {
+		h.SetAlign(mode)
+	}
+}
+
+// AlignColumns sets the alignment of the given columns. No column is changed if one of them does not exist.
+// It returns an *exception.ColumnDoNotExistError if a column does not exist.
+func (tb *Table) AlignColumns(columns []string, mode int) error {
+	for _, column := range columns {
+		if !tb.Columns.Exist(column) {
+			return exception.ColumnDoNotExist(column)
+		}
+	}
+	for _, column := range columns {
+		tb.Columns.Get(column).SetAlign(mode)
+	}
+	return nil
+}
+
+// SetHeaderFormatter sets a function that transforms the column names when the header is printed, e.g. UpperHeader
+// or TitleHeader. The columns keep their names, so AddRow and GetValues are not affected. A nil function prints the
+// names as they are, which is the default.
+func (tb *Table) SetHeaderFormatter(fn func(string) string) {
+	tb.headerFormatter = fn
+}
+
+// UpperHeader is a header formatter that prints the column names in upper case.
+func UpperHeader(name string) string {
+	return strings.ToUpper(name)
+}
+
+// TitleHeader is a header formatter that prints snake_case and kebab-case column names in Title Case, e.g.
+// "first_name" as "First Name".
+func TitleHeader(name string) string {
+	words := strings.FieldsFunc(name, func(r rune) bool {
+		return r == '_' || r == '-' || r == ' '
+	})
+	for index := range words {
+		words[index] = util.Capitalize(words[index])
+	}
+	return strings.Join(words, " ")
+}
+
+// SetHeaderRepeat makes PrintTable print the header again, between separators, after every everyN printed rows, so
+// that long tables stay readable while scrolling. The repeated header has the same column widths as the top one. A
+// value less than or equal to 0 prints the header once, which is the default.
+func (tb *Table) SetHeaderRepeat(everyN int) {
+	tb.headerRepeat = everyN
+}
+
+// SetTabWidth sets the distance between the tab stops used to expand the tabs of the cell values into spaces when
+// the table is printed, so that they do not break the alignment. The values themselves keep their tabs. A width
+// less than 1 is treated as 1. The default width is 4.
+func (tb *Table) SetTabWidth(width int) {
+	if width < 1 {
+		width = 1
+	}
+	tb.tabWidth = width
+}
+
+// TrimCells controls whether the leading and trailing whitespace of the cell values is removed when the table is
+// printed, e.g. for data imported from messy CSV files. The stored values are not changed. It is disabled by default.
+func (tb *Table) TrimCells(enable bool) {
+	tb.trimCells = enable
+}
+
+// SetHeaderAlign sets the alignment of the column name in the header, leaving the alignment of the values to Align.
+// Until it is set, the header uses the alignment of the column.
+// It returns an *exception.ColumnDoNotExistError if the column does not exist.
+func (tb *Table) SetHeaderAlign(column string, mode int) error {
+	col := tb.Columns.Get(column)
+	if col == nil {
+		return exception.ColumnDoNotExist(column)
+	}
+	col.SetHeaderAlign(mode)
+	return nil
+}
+
 func (tb *Table) ToJsonFile(path string, indent int) error {
 	if !util.IsJsonFile(path) {
 		return fmt.Errorf("%s: not a regular json file", path)
@@ -338,7 +1116,10 @@ func (tb *Table) ToJsonFile(path string, indent int) error {
 	return nil
 }
 
-func (tb *Table) ToCSVFile(path string) error {
+// ToCSVFile saves the columns and the rows of the table to a CSV file. The options control the quoting and the line
+// terminator, e.g. table.AlwaysQuote() and table.UseCRLF(); without options the file is written with the rules of
+// encoding/csv.
+func (tb *Table) ToCSVFile(path string, options ...CSVWriteOption) error {
 	if !util.IsCSVFile(path) {
 		return exception.NotARegularCSVFile(path)
 	}
@@ -347,29 +1128,110 @@ func (tb *Table) ToCSVFile(path string) error {
 		return err
 	}
 	defer file.Close()
-	writer := csv.NewWriter(file)
 
-	contents := make([][]string, 0)
-	columns := tb.GetColumns()
-	contents = append(contents, columns)
-	for _, value := range tb.GetValues() {
-		content := make([]string, 0)
-		for _, col := range columns {
-			content = append(content, value[col])
-		}
-		contents = append(contents, content)
+	tb.rLock()
+	defer tb.rUnlock()
+	return tb.writeCSV(file, newCSVWriteOptions(options))
+}
+
+// AppendCSVFile appends the rows of a CSV file whose header holds the same columns as the table, in the same order.
+// A row with fewer fields than the columns is filled with the column defaults. Either all the rows are appended or
+// none of them.
+// Return error types:
+//   - *exception.FileDoNotExistError and *exception.NotARegularCSVFileError: It returned if the path is not a CSV file.
+//   - *exception.CSVHeaderMismatchError: It returned if the header does not match the columns.
+//   - *exception.RowLengthNotEqualColumnsError: It returned if a row has more fields than the columns, with its line
+//       number.
+//   - *exception.InvalidCellValueError: It returned if a value is rejected by the type of its column.
+func (tb *Table) AppendCSVFile(path string) error {
+	if !util.IsFile(path) {
+		return exception.FileDoNotExist(path)
+	}
+	if !util.IsCSVFile(path) {
+		return exception.NotARegularCSVFile(path)
 	}
 
-	err = writer.WriteAll(contents)
+	lines, err := util.ReadCSVFile(path)
 	if err != nil {
 		return err
 	}
-	writer.Flush()
-	err = writer.Error()
+	header := make([]string, 0)
+	if len(lines) > 0 {
+		header = lines[0]
+		lines = lines[1:]
+	}
+	columns := tb.GetColumns()
+	if len(header) != len(columns) {
+		return exception.CSVHeaderMismatch(path, header, columns)
+	}
+	for index := range header {
+		if header[index] != columns[index] {
+			return exception.CSVHeaderMismatch(path, header, columns)
+		}
+	}
+
+	tb.lock()
+	defer tb.unlock()
+	added := make([]map[string]cell.Cell, 0, len(lines))
+	for index, line := range lines {
+		if len(line) > len(columns) {
+			return exception.RowLengthNotEqualColumnsAtLine(index+2, len(line), len(columns))
+		}
+
+		row := make(map[string]string)
+		for i := range line {
+			row[columns[i]] = line[i]
+		}
+		rowMap, err := tb.toRowMap(row)
+		if err != nil {
+			return err
+		}
+		added = append(added, toRow(rowMap))
+	}
+
+	tb.Row = append(tb.Row, added...)
+	tb.newRows += len(added)
+	tb.evict()
+	return nil
+}
+
+// ToXLSXFile saves the table data to an Excel workbook with a single sheet. The first row of the sheet holds the
+// columns and the alignment of each column is kept as the horizontal alignment of its cells.
+func (tb *Table) ToXLSXFile(path string) error {
+	if !util.IsXLSXFile(path) {
+		return exception.NotARegularXLSXFile(path)
+	}
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0666)
 	if err != nil {
 		return err
 	}
-	return nil
+	defer file.Close()
+
+	tb.rLock()
+	defer tb.rUnlock()
+	aligns := make([]string, 0)
+	for _, col := range tb.Columns.base {
+		aligns = append(aligns, col.AlignString())
+	}
+	return xlsx.Write(file, tb.records(), aligns)
+}
+
+// records returns the columns followed by the values of each row, both in column order.
+func (tb *Table) records() [][]string {
+	contents := make([][]string, 0)
+	columns := make([]string, 0)
+	for _, col := range tb.Columns.base {
+		columns = append(columns, col.Original())
+	}
+	contents = append(contents, columns)
+	for _, row := range tb.Row {
+		content := make([]string, 0)
+		for _, col := range columns {
+			content = append(content, row[col].String())
+		}
+		contents = append(contents, content)
+	}
+	return contents
 }
 
 func (tb *Table) HasColumn(column string) bool {
@@ -394,3 +1256,353 @@ func (tb *Table) SetColumnColor(columnName string, display, fount, background in
 		}
 	}
 }
+
+// AddColorRule adds a rule that colors the cells of the column whose value matches the predicate when the table is
+// printed, e.g. in red when a score is less than 60. The rules of a column are evaluated in the order they were added
+// and the first matching rule wins. The colors take the same values as in SetColumnColor, and override the color of
+// the row set by SetRowColorFunc. Cells that match no rule are printed as before.
+// It returns an *exception.ColumnDoNotExistError if the column does not exist.
+func (tb *Table) AddColorRule(column string, predicate func(value string) bool, display, fount, background int) error {
+	col := tb.Columns.Get(column)
+	if col == nil {
+		return exception.ColumnDoNotExist(column)
+	}
+
+	if background != 0 {
+		background += 10
+	}
+	col.AddColorRule(predicate, display, fount, background)
+	return nil
+}
+
+// SetRowColorFunc sets a function that colors whole rows by their values, e.g. in red when the status is "FAILED".
+// PrintTable calls it for every printed row with a copy of its values, and when ok is true the row cells are printed
+// in the returned colors, which take the same values as in SetColumnColor. Column colors only apply to the header,
+// so they never conflict with a row color. A nil function leaves the rows uncolored, which is the default.
+func (tb *Table) SetRowColorFunc(fn func(row map[string]string) (display, fount, background int, ok bool)) {
+	tb.rowColorFunc = fn
+}
+
+// rowColor returns the color of the row given by the row color function, or nil if the row is not colored.
+func (tb *Table) rowColor(row map[string]cell.Cell) *color.Color {
+	if tb.rowColorFunc == nil {
+		return nil
+	}
+
+	values := make(map[string]string)
+	for k, v := range row {
+		values[k] = v.String()
+	}
+	display, fount, background, ok := tb.rowColorFunc(values)
+	if !ok {
+		return nil
+	}
+	if background != 0 {
+		background += 10
+	}
+	return &color.Color{Display: display, Font: fount, Background: background}
+}
+
+// SetColumnSeparatorAfter sets the separator printed after the column, in place of the "|" (or the space when the
+// border is closed) that follows it. It returns an *exception.ColumnDoNotExistError if the column does not exist.
+func (tb *Table) SetColumnSeparatorAfter(column, sep string) error {
+	col := tb.Columns.Get(column)
+	if col == nil {
+		return exception.ColumnDoNotExist(column)
+	}
+	col.SetSeparator(sep)
+	return nil
+}
+
+// SetColumnListSeparator makes PrintTable split the values of the column on sep and print each item on its own line
+// with a "• " prefix, e.g. for a column of comma separated tags. Values without sep are printed as they are, and an
+// empty sep prints the values as they are. Nothing happens if the column does not exist.
+func (tb *Table) SetColumnListSeparator(column string, sep string) {
+	col := tb.Columns.Get(column)
+	if col != nil {
+		col.SetListSeparator(sep)
+	}
+}
+
+// SetMaxRowHeight limits the number of lines a multi-line row is printed on. Lines beyond the limit are dropped and
+// the last visible line ends with "...". A value less than or equal to 0 means unlimited, which is the default.
+func (tb *Table) SetMaxRowHeight(lines int) {
+	tb.maxRowHeight = lines
+}
+
+// ReorderColumns rearranges the columns into the given order. The columns must be a permutation of the existing
+// columns, otherwise an *exception.ColumnsMismatchError listing the missing and extra columns is returned. Rows are
+// keyed by column name, so only the order of the columns changes.
+func (tb *Table) ReorderColumns(columns []string) error {
+	seen := make(map[string]bool)
+	missing := make([]string, 0)
+	extra := make([]string, 0)
+	for _, column := range columns {
+		if seen[column] || !tb.Columns.Exist(column) {
+			extra = append(extra, column)
+		}
+		seen[column] = true
+	}
+	for _, col := range tb.Columns.base {
+		if !seen[col.Original()] {
+			missing = append(missing, col.Original())
+		}
+	}
+	if len(missing) > 0 || len(extra) > 0 {
+		return exception.ColumnsMismatch(missing, extra)
+	}
+
+	base := make([]*cell.Column, 0)
+	for _, column := range columns {
+		base = append(base, tb.Columns.Get(column))
+	}
+	tb.Columns.base = base
+	return nil
+}
+
+// SetTrimTrailingSpace controls whether trailing spaces are removed from every printed line. Borders are kept, so
+// this mainly affects the output when the border is closed. It is disabled by default.
+func (tb *Table) SetTrimTrailingSpace(enabled bool) {
+	tb.trimTrailingSpace = enabled
+}
+
+// RenameColumn changes the name of a column. The cell values, default, alignment and color of the column are kept.
+// It returns an *exception.ColumnDoNotExistError if the column old does not exist, and an error if the column new
+// already exists.
+func (tb *Table) RenameColumn(old, new string) error {
+	tb.lock()
+	defer tb.unlock()
+	if !tb.Columns.Exist(old) {
+		return exception.ColumnDoNotExist(old)
+	}
+	if old == new {
+		return nil
+	}
+
+	err := tb.Columns.Rename(old, new)
+	if err != nil {
+		return err
+	}
+	for _, row := range tb.Row {
+		row[new] = row[old]
+		delete(row, old)
+	}
+	return nil
+}
+
+// ShowRowNumbers controls whether PrintTable prints a leading "#" column that numbers the rows from 1. The numbers
+// are not stored in the table, so GetValues is not affected. It is disabled by default.
+func (tb *Table) ShowRowNumbers(enable bool) {
+	tb.rowNumbers = enable
+}
+
+// SetNullText sets the placeholder printed in place of empty cells, e.g. "NULL" or "-". The stored values and
+// GetValues are not affected. An empty text, the default, prints empty cells as blank.
+func (tb *Table) SetNullText(text string) {
+	tb.nullText = text
+}
+
+// SetPadding sets the number of spaces printed on each side of the cell content when the border is open. A negative
+// value is treated as 0. The default padding is 1.
+func (tb *Table) SetPadding(n int) {
+	if n < 0 {
+		n = 0
+	}
+	tb.padding = n
+}
+
+// SetCompact controls whether the table is printed as the tightest grid, e.g. |value|value|: the cells have no
+// padding and the columns are separated by a single "|", whatever SetPadding and SetColumnSeparatorAfter set. Unlike
+// CloseBorder, the border is kept. It is disabled by default.
+func (tb *Table) SetCompact(enable bool) {
+	tb.compact = enable
+}
+
+// SetBorderSides chooses the parts of the border that are printed when the border is open: the top and bottom
+// lines, the left and right edges, the inner horizontal line below the header and the inner vertical lines between
+// columns. All the parts are printed by default.
+func (tb *Table) SetBorderSides(top, bottom, left, right, innerH, innerV bool) {
+	tb.sides = borderSides{top, bottom, left, right, innerH, innerV}
+}
+
+// FitWidth limits the width of the printed table to maxWidth characters. When the table is wider, the columns wider
+// than their minimum are shrunk in proportion to their extra width, and the cells that no longer fit end with "...".
+// The minimum width of a column is the length of its name, and at least 3. A value less than or equal to 0 removes
+// the limit, which is the default.
+func (tb *Table) FitWidth(maxWidth int) {
+	tb.fitWidth = maxWidth
+}
+
+// SetTitle sets a title printed centered above the table. An empty title, the default, prints no title line.
+func (tb *Table) SetTitle(title string) {
+	tb.title = title
+}
+
+// Reverse reverses the order of the rows in place.
+func (tb *Table) Reverse() {
+	tb.lock()
+	defer tb.unlock()
+	for i, j := 0, len(tb.Row)-1; i < j; i, j = i+1, j-1 {
+		tb.Row[i], tb.Row[j] = tb.Row[j], tb.Row[i]
+	}
+	tb.rowsChanged()
+}
+
+// SliceRows returns a new table holding copies of the rows tb.Row[start:end]. The new table has the columns and the
+// settings of tb.
+// It returns an *exception.RowIndexOutOfRangeError if start is negative or greater than the length of the table, or
+// if end is less than start or greater than the length of the table.
+func (tb *Table) SliceRows(start, end int) (*Table, error) {
+	tb.rLock()
+	defer tb.rUnlock()
+	if start < 0 || start > len(tb.Row) {
+		return nil, exception.RowIndexOutOfRange(start, len(tb.Row))
+	}
+	if end < start || end > len(tb.Row) {
+		return nil, exception.RowIndexOutOfRange(end, len(tb.Row))
+	}
+
+	sliced := tb.emptyCopy()
+	for _, row := range tb.Row[start:end] {
+		sliced.Row = append(sliced.Row, copyRow(row))
+	}
+	return sliced, nil
+}
+
+// Diff compares the rows of tb and other, which must have the same columns in any order. It returns the rows of other
+// that are not in tb as added, and the rows of tb that are not in other as removed, each in table order. Rows are
+// equal when all their values are equal, and a row repeated n times only matches n equal rows of the other table.
+// It returns an *exception.ColumnsMismatchError listing the columns of tb missing from other and the extra columns
+// of other if the columns differ.
+func (tb *Table) Diff(other *Table) (added, removed []map[string]string, err error) {
+	// other is copied first, so that the two tables are never locked at the same time
+	other.rLock()
+	copied := other.emptyCopy()
+	for _, row := range other.Row {
+		copied.Row = append(copied.Row, copyRow(row))
+	}
+	other.rUnlock()
+	other = copied
+
+	tb.rLock()
+	defer tb.rUnlock()
+	missing := make([]string, 0)
+	extra := make([]string, 0)
+	for _, col := range tb.Columns.base {
+		if !other.Columns.Exist(col.Original()) {
+			missing = append(missing, col.Original())
+		}
+	}
+	for _, col := range other.Columns.base {
+		if !tb.Columns.Exist(col.Original()) {
+			extra = append(extra, col.Original())
+		}
+	}
+	if len(missing) > 0 || len(extra) > 0 {
+		return nil, nil, exception.ColumnsMismatch(missing, extra)
+	}
+
+	counts := make(map[string]int)
+	for _, row := range tb.Row {
+		counts[tb.rowKey(row)]++
+	}
+	added = make([]map[string]string, 0)
+	for _, row := range other.Row {
+		key := tb.rowKey(row)
+		if counts[key] > 0 {
+			counts[key]--
+			continue
+		}
+		added = append(added, rowValues(row))
+	}
+
+	removed = make([]map[string]string, 0)
+	for _, row := range tb.Row {
+		key := tb.rowKey(row)
+		if counts[key] > 0 {
+			counts[key]--
+			removed = append(removed, rowValues(row))
+		}
+	}
+	return added, removed, nil
+}
+
+// rowValues returns a copy of the values of a row.
+func rowValues(row map[string]cell.Cell) map[string]string {
+	values := make(map[string]string)
+	for k, v := range row {
+		values[k] = v.String()
+	}
+	return values
+}
+
+// CollapseBy merges the rows that share the value of the key column into the first of them. In the other columns,
+// the distinct non-empty values of the merged rows are joined with sep, in the order they appear. The rows keep the
+// order of their first occurrence.
+// It returns an *exception.ColumnDoNotExistError if the key column does not exist.
+func (tb *Table) CollapseBy(keyColumn string, sep string) error {
+	tb.lock()
+	defer tb.unlock()
+	if !tb.Columns.Exist(keyColumn) {
+		return exception.ColumnDoNotExist(keyColumn)
+	}
+
+	keys := make([]string, 0)
+	groups := make(map[string][]map[string]cell.Cell)
+	for _, row := range tb.Row {
+		key := row[keyColumn].String()
+		if _, ok := groups[key]; !ok {
+			keys = append(keys, key)
+		}
+		groups[key] = append(groups[key], row)
+	}
+
+	rows := make([]map[string]cell.Cell, 0)
+	for _, key := range keys {
+		collapsed := map[string]cell.Cell{keyColumn: cell.CreateData(key)}
+		for _, col := range tb.Columns.base {
+			if col.Original() == keyColumn {
+				continue
+			}
+
+			seen := make(map[string]bool)
+			values := make([]string, 0)
+			for _, row := range groups[key] {
+				value := row[col.Original()].String()
+				if value == "" || seen[value] {
+					continue
+				}
+				seen[value] = true
+				values = append(values, value)
+			}
+			collapsed[col.Original()] = cell.CreateData(strings.Join(values, sep))
+		}
+		rows = append(rows, collapsed)
+	}
+	tb.Row = rows
+	tb.rowsChanged()
+	return nil
+}
+
+// GroupBy partitions the rows into tables keyed by the distinct values of the column. Each table has the columns and
+// the settings of tb, and keeps the order of its rows.
+// It returns an *exception.ColumnDoNotExistError if the column does not exist.
+func (tb *Table) GroupBy(column string) (map[string]*Table, error) {
+	tb.rLock()
+	defer tb.rUnlock()
+	if !tb.Columns.Exist(column) {
+		return nil, exception.ColumnDoNotExist(column)
+	}
+
+	groups := make(map[string]*Table)
+	for _, row := range tb.Row {
+		key := row[column].String()
+		group, ok := groups[key]
+		if !ok {
+			group = tb.emptyCopy()
+			groups[key] = group
+		}
+		group.Row = append(group.Row, copyRow(row))
+	}
+	return groups, nil
+}
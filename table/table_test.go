@@ -0,0 +1,89 @@
+package table
+
+import (
+	"io/ioutil"
+	"os"
+	"reflect"
+	"testing"
+)
+
+// newTable returns a table with the given columns and rows, each row holding one value per column in order.
+func newTable(t *testing.T, columns []string, rows ...[]string) *Table {
+	t.Helper()
+	set := &Set{}
+	for _, column := range columns {
+		if err := set.Add(column); err != nil {
+			t.Fatalf("add column %q: %v", column, err)
+		}
+	}
+	tb := CreateTable(set)
+	for _, row := range rows {
+		if err := tb.AddRow(row); err != nil {
+			t.Fatalf("add row %v: %v", row, err)
+		}
+	}
+	return tb
+}
+
+// captureStdout returns what fn prints in STDOUT.
+func captureStdout(t *testing.T, fn func()) string {
+	t.Helper()
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	stdout := os.Stdout
+	os.Stdout = w
+	defer func() { os.Stdout = stdout }()
+
+	fn()
+	w.Close()
+	out, err := ioutil.ReadAll(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(out)
+}
+
+func TestReverse(t *testing.T) {
+	cases := []struct {
+		rows []string
+		want []string
+	}{
+		{nil, []string{}},
+		{[]string{"1"}, []string{"1"}},
+		{[]string{"1", "2"}, []string{"2", "1"}},
+		{[]string{"1", "2", "3"}, []string{"3", "2", "1"}},
+		{[]string{"1", "2", "3", "4"}, []string{"4", "3", "2", "1"}},
+	}
+	for _, c := range cases {
+		tb := newTable(t, []string{"id"})
+		for _, id := range c.rows {
+			if err := tb.AddRow([]string{id}); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		tb.Reverse()
+		got, err := tb.Column("id")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(got, c.want) {
+			t.Errorf("Reverse of %q = %q, want %q", c.rows, got, c.want)
+		}
+	}
+}
+
+func TestPrintVerticalRawValues(t *testing.T) {
+	tb := newTable(t, []string{"id", "price"}, []string{"1", "1234.5"}, []string{"22", ""})
+	tb.SetNullText("NULL")
+	if err := tb.SetColumnFormat("price", NumberFormat(2)); err != nil {
+		t.Fatal(err)
+	}
+
+	want := "*** row 1 ***\n   id: 1\nprice: 1234.5\n*** row 2 ***\n   id: 22\nprice: \n"
+	if got := captureStdout(t, tb.PrintVertical); got != want {
+		t.Errorf("got\n%q\nwant\n%q", got, want)
+	}
+}
@@ -0,0 +1,86 @@
+package table
+
+import (
+	"github.com/liushuochen/gotable/util"
+	"golang.org/x/term"
+	"io"
+	"os"
+)
+
+// AutoFit controls whether the tables printed in STDOUT are fitted to the width of the terminal, as FitWidth does.
+// When STDOUT is not a terminal, e.g. it is redirected to a file or a pipe, the table keeps its full width. A width
+// set by FitWidth that is narrower than the terminal still applies. It is disabled by default.
+func (tb *Table) AutoFit(enable bool) {
+	tb.autoFit = enable
+}
+
+// stdoutWidth returns the max width of the tables printed in STDOUT: the width of the terminal when auto fit is
+// enabled and STDOUT is a terminal, unless the width set by FitWidth is narrower.
+func (tb *Table) stdoutWidth() int {
+	if !tb.autoFit {
+		return tb.fitWidth
+	}
+
+	fd := int(os.Stdout.Fd())
+	if !term.IsTerminal(fd) {
+		return tb.fitWidth
+	}
+	width, _, err := term.GetSize(fd)
+	if err != nil || width <= 0 || tb.fitWidth > 0 && tb.fitWidth < width {
+		return tb.fitWidth
+	}
+	return width
+}
+
+// Color modes of a table. By default colors are written to terminals, unless the NO_COLOR environment variable is
+// set or TERM is "dumb".
+const (
+	autoColor = iota
+	noColor
+	forceColor
+)
+
+// DisableColor prints the table without colors: PrintTable and the other printing methods write no escape sequence
+// at all. Colors are also left out when the output is not a terminal, e.g. when it is redirected to a file, and when
+// the NO_COLOR environment variable is set or TERM is "dumb".
+func (tb *Table) DisableColor() {
+	tb.colorMode = noColor
+}
+
+// ForceColor writes the colors of the table to any output, even when it is not a terminal or NO_COLOR is set. It
+// overrides DisableColor, and DisableColor overrides it.
+func (tb *Table) ForceColor() {
+	tb.colorMode = forceColor
+}
+
+// colored reports whether colors are written to w.
+func (tb *Table) colored(w io.Writer) bool {
+	switch tb.colorMode {
+	case noColor:
+		return false
+	case forceColor:
+		return true
+	}
+
+	if os.Getenv("NO_COLOR") != "" || os.Getenv("TERM") == "dumb" {
+		return false
+	}
+	return isTerminal(w)
+}
+
+// isTerminal reports whether w is a terminal. It is a variable so that tests can stub it.
+var isTerminal = func(w io.Writer) bool {
+	file, ok := w.(*os.File)
+	return ok && term.IsTerminal(int(file.Fd()))
+}
+
+// linesFor returns the lines to write to w, without their colors if colors are not written to w.
+func (tb *Table) linesFor(w io.Writer, lines []string) []string {
+	if tb.colored(w) {
+		return lines
+	}
+	for index := range lines {
+		lines[index] = util.StripColor(lines[index])
+	}
+	return lines
+}
@@ -0,0 +1,82 @@
+package table
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"strings"
+	"testing"
+)
+
+// setenv sets the environment variables for the duration of the test, an empty value unsetting the variable.
+func setenv(t *testing.T, env map[string]string) {
+	t.Helper()
+	for key, value := range env {
+		old, ok := os.LookupEnv(key)
+		if value == "" {
+			os.Unsetenv(key)
+		} else {
+			os.Setenv(key, value)
+		}
+		key := key
+		t.Cleanup(func() {
+			if ok {
+				os.Setenv(key, old)
+			} else {
+				os.Unsetenv(key)
+			}
+		})
+	}
+}
+
+func TestColorEnvironment(t *testing.T) {
+	cases := []struct {
+		name    string
+		env     map[string]string
+		mode    func(tb *Table)
+		colored bool
+	}{
+		{"terminal", map[string]string{"NO_COLOR": "", "TERM": "xterm"}, func(tb *Table) {}, true},
+		{"NO_COLOR", map[string]string{"NO_COLOR": "1", "TERM": "xterm"}, func(tb *Table) {}, false},
+		{"TERM=dumb", map[string]string{"NO_COLOR": "", "TERM": "dumb"}, func(tb *Table) {}, false},
+		{"ForceColor with NO_COLOR", map[string]string{"NO_COLOR": "1", "TERM": "dumb"}, (*Table).ForceColor, true},
+		{"DisableColor after ForceColor", map[string]string{"NO_COLOR": "1"}, func(tb *Table) {
+			tb.ForceColor()
+			tb.DisableColor()
+		}, false},
+	}
+	// the buffer is taken for a terminal, so that only the environment and the color mode leave the colors out
+	stub := isTerminal
+	isTerminal = func(w io.Writer) bool { return true }
+	defer func() { isTerminal = stub }()
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			setenv(t, c.env)
+			tb := newTable(t, []string{"id", "name"}, []string{"1", "a"})
+			tb.SetColumnColor("name", 0, 31, 0)
+			c.mode(tb)
+
+			buffer := new(bytes.Buffer)
+			if err := tb.RenderWithLineHook(buffer, nil); err != nil {
+				t.Fatal(err)
+			}
+			if got := strings.Contains(buffer.String(), "\x1b"); got != c.colored {
+				t.Errorf("escape sequence written = %v, want %v:\n%q", got, c.colored, buffer.String())
+			}
+		})
+	}
+}
+
+func TestColorNotTerminal(t *testing.T) {
+	setenv(t, map[string]string{"NO_COLOR": "", "TERM": "xterm"})
+	tb := newTable(t, []string{"name"}, []string{"a"})
+	tb.SetColumnColor("name", 0, 31, 0)
+	buffer := new(bytes.Buffer)
+	if err := tb.RenderWithLineHook(buffer, nil); err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(buffer.String(), "\x1b") {
+		t.Errorf("escape sequence written to a buffer:\n%q", buffer.String())
+	}
+}
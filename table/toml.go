@@ -0,0 +1,88 @@
+package table
+
+import (
+	"fmt"
+	"github.com/liushuochen/gotable/exception"
+	"github.com/liushuochen/gotable/util"
+	"io/ioutil"
+	"regexp"
+	"strings"
+)
+
+// tomlBareKey matches the keys that can be written without quotes.
+var tomlBareKey = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+// TOML returns the rows as a TOML array of tables named rows: one [[rows]] table per row, whose keys follow the
+// column order. Values are written as basic strings, and keys are quoted when they are not bare keys. A table without
+// rows is written as an empty array.
+func (tb *Table) TOML() (string, error) {
+	tb.rLock()
+	defer tb.rUnlock()
+	if len(tb.Row) == 0 {
+		return "rows = []\n", nil
+	}
+
+	builder := new(strings.Builder)
+	for index, row := range tb.Row {
+		if index > 0 {
+			builder.WriteString("\n")
+		}
+		builder.WriteString("[[rows]]\n")
+		for _, col := range tb.Columns.base {
+			builder.WriteString(tomlKey(col.Original()) + " = " + tomlString(row[col.Original()].String()) + "\n")
+		}
+	}
+	return builder.String(), nil
+}
+
+// ToTOMLFile saves the rows to a .toml file.
+func (tb *Table) ToTOMLFile(path string) error {
+	if !util.IsTOMLFile(path) {
+		return exception.NotARegularTOMLFile(path)
+	}
+
+	content, err := tb.TOML()
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, []byte(content), 0666)
+}
+
+func tomlKey(key string) string {
+	if tomlBareKey.MatchString(key) {
+		return key
+	}
+	return tomlString(key)
+}
+
+// tomlString returns s as a TOML basic string. Quotes, backslashes and control characters are escaped.
+func tomlString(s string) string {
+	builder := new(strings.Builder)
+	builder.WriteString(`"`)
+	for _, c := range s {
+		switch c {
+		case '"':
+			builder.WriteString(`\"`)
+		case '\\':
+			builder.WriteString(`\\`)
+		case '\b':
+			builder.WriteString(`\b`)
+		case '\t':
+			builder.WriteString(`\t`)
+		case '\n':
+			builder.WriteString(`\n`)
+		case '\f':
+			builder.WriteString(`\f`)
+		case '\r':
+			builder.WriteString(`\r`)
+		default:
+			if c < 0x20 || c == 0x7f {
+				fmt.Fprintf(builder, `\u%04X`, c)
+			} else {
+				builder.WriteRune(c)
+			}
+		}
+	}
+	builder.WriteString(`"`)
+	return builder.String()
+}
@@ -0,0 +1,78 @@
+package table
+
+import (
+	"fmt"
+	"github.com/liushuochen/gotable/exception"
+	"regexp"
+	"strconv"
+	"time"
+)
+
+// SetColumnType sets the validator of a column. AddRow runs the validator on the value of the column, defaults
+// included, and rejects the row with an *exception.InvalidCellValueError when it returns an error. A nil validator
+// removes the type. Existing rows are not validated.
+// It returns an *exception.ColumnDoNotExistError if the column does not exist.
+func (tb *Table) SetColumnType(column string, validator func(string) error) error {
+	col := tb.Columns.Get(column)
+	if col == nil {
+		return exception.ColumnDoNotExist(column)
+	}
+	col.SetValidator(validator)
+	return nil
+}
+
+// validate runs the column validators on the values of a row.
+func (tb *Table) validate(row map[string]string) error {
+	for _, col := range tb.Columns.base {
+		if col.Validator() == nil {
+			continue
+		}
+		value := row[col.Original()]
+		err := col.Validator()(value)
+		if err != nil {
+			return exception.InvalidCellValue(col.Original(), value, err)
+		}
+	}
+	return nil
+}
+
+// IntColumn is a column validator that accepts integers.
+func IntColumn(value string) error {
+	_, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return fmt.Errorf("%q is not an integer", value)
+	}
+	return nil
+}
+
+// FloatColumn is a column validator that accepts floating-point numbers.
+func FloatColumn(value string) error {
+	_, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return fmt.Errorf("%q is not a number", value)
+	}
+	return nil
+}
+
+// DateColumn returns a column validator that accepts dates in the given time layout, e.g. "2006-01-02".
+func DateColumn(layout string) func(string) error {
+	return func(value string) error {
+		_, err := time.Parse(layout, value)
+		if err != nil {
+			return fmt.Errorf("%q is not a date in layout %s", value, layout)
+		}
+		return nil
+	}
+}
+
+// RegexpColumn returns a column validator that accepts the values matching the regular expression. It panics if the
+// expression can not be parsed.
+func RegexpColumn(expr string) func(string) error {
+	pattern := regexp.MustCompile(expr)
+	return func(value string) error {
+		if !pattern.MatchString(value) {
+			return fmt.Errorf("%q does not match %s", value, expr)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,86 @@
+package table
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/liushuochen/gotable/exception"
+	"github.com/liushuochen/gotable/util"
+	"io/ioutil"
+	"regexp"
+	"strings"
+)
+
+// yamlPlain matches the strings that can be written as plain YAML scalars and still read back as the same string.
+var yamlPlain = regexp.MustCompile(`^[A-Za-z_/.][A-Za-z0-9_ ./()+-]*$`)
+
+// yamlNumber matches the beginning of the scalars that YAML 1.1 or 1.2 may read as an integer or a float, e.g. "1",
+// "-2", ".5" or "._5", rather than as a string.
+var yamlNumber = regexp.MustCompile(`^[-+]?(\.[0-9_]|[0-9])`)
+
+// yamlReserved holds the plain scalars that YAML would read as a boolean, null, infinity or NaN instead of a string.
+var yamlReserved = map[string]bool{
+	"true": true, "false": true, "yes": true, "no": true, "on": true, "off": true, "y": true, "n": true,
+	"null": true, "~": true, ".inf": true, "-.inf": true, "+.inf": true, ".nan": true,
+}
+
+// YAML returns the rows as a YAML sequence of mappings, the same shape as the Json method. Keys follow the column
+// order, and values are quoted whenever YAML would not read them back as the same string.
+func (tb *Table) YAML() (string, error) {
+	tb.rLock()
+	defer tb.rUnlock()
+	if len(tb.Row) == 0 {
+		return "[]\n", nil
+	}
+
+	builder := new(strings.Builder)
+	for _, row := range tb.Row {
+		for index, col := range tb.Columns.base {
+			if index == 0 {
+				builder.WriteString("- ")
+			} else {
+				builder.WriteString("  ")
+			}
+			key, err := yamlScalar(col.Original())
+			if err != nil {
+				return "", err
+			}
+			value, err := yamlScalar(row[col.Original()].String())
+			if err != nil {
+				return "", err
+			}
+			builder.WriteString(key + ": " + value + "\n")
+		}
+	}
+	return builder.String(), nil
+}
+
+// ToYAMLFile saves the rows to a .yaml or .yml file.
+func (tb *Table) ToYAMLFile(path string) error {
+	if !util.IsYAMLFile(path) {
+		return exception.NotARegularYAMLFile(path)
+	}
+
+	content, err := tb.YAML()
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, []byte(content), 0666)
+}
+
+// yamlScalar returns s as a plain scalar when it is safe, otherwise as a double-quoted scalar. A JSON string is a
+// valid YAML double-quoted scalar.
+func yamlScalar(s string) (string, error) {
+	if yamlPlain.MatchString(s) && !yamlNumber.MatchString(s) && !strings.HasSuffix(s, " ") &&
+		!yamlReserved[strings.ToLower(s)] {
+		return s, nil
+	}
+
+	buffer := new(bytes.Buffer)
+	encoder := json.NewEncoder(buffer)
+	encoder.SetEscapeHTML(false)
+	err := encoder.Encode(s)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSuffix(buffer.String(), "\n"), nil
+}
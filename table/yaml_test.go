@@ -0,0 +1,48 @@
+package table
+
+import "testing"
+
+func TestYAMLScalarQuotesReservedWords(t *testing.T) {
+	cases := map[string]string{
+		"abc":        "abc",
+		".gitignore": ".gitignore",
+		".inf":       `".inf"`,
+		".Inf":       `".Inf"`,
+		".INF":       `".INF"`,
+		"-.inf":      `"-.inf"`,
+		"+.inf":      `"+.inf"`,
+		".nan":       `".nan"`,
+		".NaN":       `".NaN"`,
+		".5":         `".5"`,
+		".123":       `".123"`,
+		".5e3":       `".5e3"`,
+		"._5":        `"._5"`,
+		"-.5":        `"-.5"`,
+		".":          ".",
+		"./bin":      "./bin",
+		"true":       `"true"`,
+		"Null":       `"Null"`,
+		"1":          `"1"`,
+		"a: b":       `"a: b"`,
+	}
+	for value, want := range cases {
+		got, err := yamlScalar(value)
+		if err != nil {
+			t.Fatalf("%q: %v", value, err)
+		}
+		if got != want {
+			t.Errorf("yamlScalar(%q) = %s, want %s", value, got, want)
+		}
+	}
+}
+
+func TestYAML(t *testing.T) {
+	tb := newTable(t, []string{"a", "b"}, []string{"x", ".inf"})
+	got, err := tb.YAML()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "- a: x\n  b: \".inf\"\n"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
@@ -1,6 +1,8 @@
 package util
 
 import (
+	"encoding/csv"
+	"io"
 	"os"
 	"strings"
 )
@@ -26,3 +28,46 @@ func IsJsonFile(path string) bool {
 func IsCSVFile(path string) bool {
 	return isFormatFile(path, "csv")
 }
+
+func IsXLSXFile(path string) bool {
+	return isFormatFile(path, "xlsx")
+}
+
+func IsPNGFile(path string) bool {
+	return isFormatFile(path, "png")
+}
+
+func IsJSONLFile(path string) bool {
+	return isFormatFile(path, "jsonl")
+}
+
+func IsYAMLFile(path string) bool {
+	return isFormatFile(path, "yaml") || isFormatFile(path, "yml")
+}
+
+func IsTOMLFile(path string) bool {
+	return isFormatFile(path, "toml")
+}
+
+// ReadCSVFile reads all the records of a CSV file. The records may have different numbers of fields.
+func ReadCSVFile(path string) ([][]string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	return ReadCSV(file)
+}
+
+// ReadCSV reads all the records of CSV data. The records may have different numbers of fields.
+func ReadCSV(r io.Reader) ([][]string, error) {
+	return NewCSVReader(r).ReadAll()
+}
+
+// NewCSVReader returns a reader of the CSV records of r, one at a time. The records may have different numbers of
+// fields.
+func NewCSVReader(r io.Reader) *csv.Reader {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	return reader
+}
@@ -1,6 +1,7 @@
 package util
 
 import (
+	"regexp"
 	"strings"
 	"unicode"
 )
@@ -9,6 +10,8 @@ const (
 	chineseSymbol = "！……（），。？、"
 )
 
+var colorPattern = regexp.MustCompile("\x1b\\[[0-9;]*m")
+
 func Capitalize(s string) string {
 	if len(s) < 1 {
 		return s
@@ -21,18 +24,24 @@ func Capitalize(s string) string {
 	return strings.ToUpper(string(s[0])) + s[1:]
 }
 
+// Length returns the number of columns s takes in a terminal. Chinese characters take two columns, and combining
+// marks, e.g. the vowel marks of Arabic and Hebrew, and format characters, e.g. the directional marks, take none.
 func Length(s string) int {
 	length := 0
 	for _, c := range s {
 		if isChinese(c) {
 			length += 2
-		} else {
+		} else if !isZeroWidth(c) {
 			length += 1
 		}
 	}
 	return length
 }
 
+func isZeroWidth(c int32) bool {
+	return unicode.In(c, unicode.Mn, unicode.Me, unicode.Cf)
+}
+
 func isChinese(c int32) bool {
 	if unicode.Is(unicode.Han, c) {
 		return true
@@ -45,3 +54,54 @@ func isChinese(c int32) bool {
 	}
 	return false
 }
+
+// StripColor removes the terminal color escape sequences from s.
+func StripColor(s string) string {
+	return colorPattern.ReplaceAllString(s, "")
+}
+
+// Truncate shortens s to at most length characters, as counted by Length, replacing the end of s with "...". It
+// returns s unchanged if it is not longer than length.
+func Truncate(s string, length int) string {
+	if Length(s) <= length {
+		return s
+	}
+	if length <= 3 {
+		return strings.Repeat(".", length)
+	}
+
+	result := ""
+	for _, c := range s {
+		if Length(result+string(c)) > length-3 {
+			break
+		}
+		result += string(c)
+	}
+	return result + "..."
+}
+
+// ExpandTabs replaces the tabs of s with spaces up to the next tab stop, tab stops being every tabWidth characters
+// as counted by Length. Each line of s starts at column 0.
+func ExpandTabs(s string, tabWidth int) string {
+	if !strings.Contains(s, "\t") {
+		return s
+	}
+
+	var builder strings.Builder
+	column := 0
+	for _, c := range s {
+		switch c {
+		case '\t':
+			spaces := tabWidth - column%tabWidth
+			builder.WriteString(strings.Repeat(" ", spaces))
+			column += spaces
+		case '\n':
+			builder.WriteRune(c)
+			column = 0
+		default:
+			builder.WriteRune(c)
+			column += Length(string(c))
+		}
+	}
+	return builder.String()
+}
@@ -0,0 +1,81 @@
+package util
+
+import (
+	"github.com/liushuochen/gotable/exception"
+	"reflect"
+)
+
+// StructColumn is a field of a struct that is mapped to a column: the name of the column, the name of the field, with
+// the names of the embedded structs it belongs to, and its index for reflect.Value.FieldByIndex.
+type StructColumn struct {
+	Name  string
+	Field string
+	Index []int
+}
+
+// StructColumns returns the columns of the struct type t, in field order. Unexported fields and fields tagged
+// `gotable:"-"` are skipped, and a field is renamed using struct tag: gotable. The fields of an embedded struct
+// without a tag are flattened into the columns of t.
+// It returns an *exception.DuplicateStructColumnError if several fields are mapped to the same column.
+func StructColumns(t reflect.Type) ([]StructColumn, error) {
+	columns := make([]StructColumn, 0)
+	fields := make(map[string]string)
+	err := collectStructColumns(t, nil, "", &columns, fields)
+	if err != nil {
+		return nil, err
+	}
+	return columns, nil
+}
+
+func collectStructColumns(t reflect.Type, index []int, prefix string, columns *[]StructColumn,
+	fields map[string]string) error {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("gotable")
+		if tag == "-" {
+			continue
+		}
+		fieldIndex := append(append([]int{}, index...), i)
+
+		embedded := field.Type
+		if embedded.Kind() == reflect.Ptr {
+			embedded = embedded.Elem()
+		}
+		if field.Anonymous && tag == "" && embedded.Kind() == reflect.Struct {
+			err := collectStructColumns(embedded, fieldIndex, prefix+field.Name+".", columns, fields)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		if field.PkgPath != "" {
+			continue
+		}
+
+		name := tag
+		if name == "" {
+			name = field.Name
+		}
+		if other, ok := fields[name]; ok {
+			return exception.DuplicateStructColumn(name, []string{other, prefix + field.Name})
+		}
+		fields[name] = prefix + field.Name
+		*columns = append(*columns, StructColumn{Name: name, Field: prefix + field.Name, Index: fieldIndex})
+	}
+	return nil
+}
+
+// FieldByIndex returns the field of the struct value at index, like reflect.Value.FieldByIndex. It returns false if
+// an embedded struct pointer on the way is nil.
+func FieldByIndex(value reflect.Value, index []int) (reflect.Value, bool) {
+	for i, x := range index {
+		if i > 0 && value.Kind() == reflect.Ptr {
+			if value.IsNil() {
+				return reflect.Value{}, false
+			}
+			value = value.Elem()
+		}
+		value = value.Field(x)
+	}
+	return value, true
+}
@@ -0,0 +1,36 @@
+package util
+
+import (
+	"reflect"
+	"testing"
+)
+
+type structColumnsBase struct {
+	ID      int
+	created string
+}
+
+type structColumnsUser struct {
+	structColumnsBase
+	Name     string `gotable:"name"`
+	Password string `gotable:"-"`
+	age      int
+	Email    string
+	internal bool `gotable:"internal"`
+}
+
+func TestStructColumns(t *testing.T) {
+	columns, err := StructColumns(reflect.TypeOf(structColumnsUser{}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []StructColumn{
+		{Name: "ID", Field: "structColumnsBase.ID", Index: []int{0, 0}},
+		{Name: "name", Field: "Name", Index: []int{1}},
+		{Name: "Email", Field: "Email", Index: []int{4}},
+	}
+	if !reflect.DeepEqual(columns, want) {
+		t.Errorf("got  %+v\nwant %+v", columns, want)
+	}
+}
@@ -0,0 +1,191 @@
+package xlsx
+
+import (
+	"archive/zip"
+	"encoding/xml"
+	"fmt"
+	"io/ioutil"
+	"path"
+	"strconv"
+	"strings"
+)
+
+type xmlWorkbook struct {
+	Sheets []struct {
+		ID string `xml:"http://schemas.openxmlformats.org/officeDocument/2006/relationships id,attr"`
+	} `xml:"sheets>sheet"`
+}
+
+type xmlRelationships struct {
+	Relationships []struct {
+		ID     string `xml:"Id,attr"`
+		Target string `xml:"Target,attr"`
+	} `xml:"Relationship"`
+}
+
+// xmlText is a rich or plain string: either a single <t> element or a list of <r> runs.
+type xmlText struct {
+	Text string `xml:"t"`
+	Runs []struct {
+		Text string `xml:"t"`
+	} `xml:"r"`
+}
+
+func (t xmlText) String() string {
+	if len(t.Runs) == 0 {
+		return t.Text
+	}
+	s := ""
+	for _, run := range t.Runs {
+		s += run.Text
+	}
+	return s
+}
+
+type xmlSharedStrings struct {
+	Items []xmlText `xml:"si"`
+}
+
+type xmlSheet struct {
+	Rows []struct {
+		Cells []struct {
+			Ref    string  `xml:"r,attr"`
+			Type   string  `xml:"t,attr"`
+			Value  string  `xml:"v"`
+			Inline xmlText `xml:"is"`
+		} `xml:"c"`
+	} `xml:"sheetData>row"`
+}
+
+// Read reads the first sheet of the workbook at path and returns its rows as strings. Missing cells in the middle
+// of a row are returned as empty strings, missing cells at the end of a row are not returned at all.
+func Read(filename string) ([][]string, error) {
+	archive, err := zip.OpenReader(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer archive.Close()
+
+	files := make(map[string]*zip.File)
+	for _, file := range archive.File {
+		files[file.Name] = file
+	}
+
+	book := new(xmlWorkbook)
+	err = decode(files, "xl/workbook.xml", book)
+	if err != nil {
+		return nil, err
+	}
+	if len(book.Sheets) == 0 {
+		return nil, fmt.Errorf("workbook %s has no sheet", filename)
+	}
+
+	rels := new(xmlRelationships)
+	err = decode(files, "xl/_rels/workbook.xml.rels", rels)
+	if err != nil {
+		return nil, err
+	}
+	sheetPath := ""
+	for _, rel := range rels.Relationships {
+		if rel.ID == book.Sheets[0].ID {
+			sheetPath = rel.Target
+			break
+		}
+	}
+	if sheetPath == "" {
+		return nil, fmt.Errorf("workbook %s: sheet %s not found", filename, book.Sheets[0].ID)
+	}
+	if strings.HasPrefix(sheetPath, "/") {
+		sheetPath = strings.TrimPrefix(sheetPath, "/")
+	} else {
+		sheetPath = path.Join("xl", sheetPath)
+	}
+
+	shared := new(xmlSharedStrings)
+	if _, ok := files["xl/sharedStrings.xml"]; ok {
+		err = decode(files, "xl/sharedStrings.xml", shared)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	sheet := new(xmlSheet)
+	err = decode(files, sheetPath, sheet)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([][]string, 0)
+	for _, r := range sheet.Rows {
+		row := make([]string, 0)
+		for _, c := range r.Cells {
+			index := len(row)
+			if c.Ref != "" {
+				index, err = columnIndex(c.Ref)
+				if err != nil {
+					return nil, fmt.Errorf("workbook %s: %v", filename, err)
+				}
+			}
+			for len(row) <= index {
+				row = append(row, "")
+			}
+
+			switch c.Type {
+			case "s":
+				i, err := strconv.Atoi(c.Value)
+				if err != nil || i < 0 || i >= len(shared.Items) {
+					return nil, fmt.Errorf("workbook %s: invalid shared string %q", filename, c.Value)
+				}
+				row[index] = shared.Items[i].String()
+			case "inlineStr":
+				row[index] = c.Inline.String()
+			case "b":
+				row[index] = strconv.FormatBool(c.Value == "1")
+			default:
+				row[index] = c.Value
+			}
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+func decode(files map[string]*zip.File, name string, v interface{}) error {
+	file, ok := files[name]
+	if !ok {
+		return fmt.Errorf("workbook part %s not found", name)
+	}
+	reader, err := file.Open()
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	content, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return err
+	}
+	return xml.Unmarshal(content, v)
+}
+
+// maxColumns is the number of columns of an Excel sheet, from A to XFD.
+const maxColumns = 16384
+
+// columnIndex converts the letters of a cell reference such as "AB12" to a zero-based column index. It returns an
+// error if the reference does not start with column letters or names a column beyond XFD.
+func columnIndex(ref string) (int, error) {
+	index := 0
+	for _, c := range ref {
+		if c < 'A' || c > 'Z' {
+			break
+		}
+		index = index*26 + int(c-'A') + 1
+		if index > maxColumns {
+			return 0, fmt.Errorf("invalid cell reference %q", ref)
+		}
+	}
+	if index == 0 {
+		return 0, fmt.Errorf("invalid cell reference %q", ref)
+	}
+	return index - 1, nil
+}
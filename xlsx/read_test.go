@@ -0,0 +1,84 @@
+package xlsx
+
+import (
+	"archive/zip"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestColumnIndex(t *testing.T) {
+	valid := map[string]int{"A1": 0, "Z9": 25, "AA1": 26, "AB12": 27, "XFD1": maxColumns - 1}
+	for ref, want := range valid {
+		got, err := columnIndex(ref)
+		if err != nil || got != want {
+			t.Errorf("columnIndex(%q) = %d, %v, want %d", ref, got, err, want)
+		}
+	}
+
+	for _, ref := range []string{"12", "a1", "", "XFE1", "ZZZZZZZZZZZZZZ1"} {
+		if _, err := columnIndex(ref); err == nil {
+			t.Errorf("columnIndex(%q) returned no error", ref)
+		}
+	}
+}
+
+// writeWorkbook writes a workbook whose first sheet holds sheetData, and returns its path.
+func writeWorkbook(t *testing.T, sheetData string) string {
+	t.Helper()
+	dir, err := ioutil.TempDir("", "gotable")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.RemoveAll(dir) })
+	path := filepath.Join(dir, "book.xlsx")
+	file, err := os.Create(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+
+	parts := map[string]string{
+		"xl/workbook.xml": `<workbook xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">` +
+			`<sheets><sheet name="Sheet1" sheetId="1" r:id="rId1"/></sheets></workbook>`,
+		"xl/_rels/workbook.xml.rels": `<Relationships>` +
+			`<Relationship Id="rId1" Target="worksheets/sheet1.xml"/></Relationships>`,
+		"xl/worksheets/sheet1.xml": `<worksheet><sheetData>` + sheetData + `</sheetData></worksheet>`,
+	}
+	archive := zip.NewWriter(file)
+	for name, content := range parts {
+		w, err := archive.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := w.Write([]byte(content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := archive.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestRead(t *testing.T) {
+	path := writeWorkbook(t, `<row><c r="A1" t="inlineStr"><is><t>a</t></is></c><c r="C1"><v>3</v></c></row>`)
+	rows, err := Read(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := [][]string{{"a", "", "3"}}; !reflect.DeepEqual(rows, want) {
+		t.Errorf("got %q, want %q", rows, want)
+	}
+}
+
+func TestReadInvalidReference(t *testing.T) {
+	path := writeWorkbook(t, `<row><c r="12"><v>1</v></c></row>`)
+	_, err := Read(path)
+	if err == nil || !strings.Contains(err.Error(), `invalid cell reference "12"`) {
+		t.Errorf("error = %v, want an invalid cell reference error", err)
+	}
+}
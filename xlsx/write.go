@@ -0,0 +1,127 @@
+package xlsx
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+)
+
+const (
+	contentTypes = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">
+<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>
+<Default Extension="xml" ContentType="application/xml"/>
+<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>
+<Override PartName="/xl/worksheets/sheet1.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>
+<Override PartName="/xl/styles.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.styles+xml"/>
+</Types>`
+
+	rootRels = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">
+<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/>
+</Relationships>`
+
+	workbook = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">
+<sheets><sheet name="Sheet1" sheetId="1" r:id="rId1"/></sheets>
+</workbook>`
+
+	workbookRels = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">
+<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/>
+<Relationship Id="rId2" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/styles" Target="styles.xml"/>
+</Relationships>`
+
+	// The cellXfs entries are referenced by index from the sheet: 0 is the default style, 1 is left aligned,
+	// 2 is centered and 3 is right aligned.
+	styles = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<styleSheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">
+<fonts count="1"><font><sz val="11"/><name val="Calibri"/></font></fonts>
+<fills count="2"><fill><patternFill patternType="none"/></fill><fill><patternFill patternType="gray125"/></fill></fills>
+<borders count="1"><border><left/><right/><top/><bottom/><diagonal/></border></borders>
+<cellStyleXfs count="1"><xf numFmtId="0" fontId="0" fillId="0" borderId="0"/></cellStyleXfs>
+<cellXfs count="4">
+<xf numFmtId="0" fontId="0" fillId="0" borderId="0" xfId="0"/>
+<xf numFmtId="0" fontId="0" fillId="0" borderId="0" xfId="0" applyAlignment="1"><alignment horizontal="left"/></xf>
+<xf numFmtId="0" fontId="0" fillId="0" borderId="0" xfId="0" applyAlignment="1"><alignment horizontal="center"/></xf>
+<xf numFmtId="0" fontId="0" fillId="0" borderId="0" xfId="0" applyAlignment="1"><alignment horizontal="right"/></xf>
+</cellXfs>
+</styleSheet>`
+)
+
+// Write writes rows to w as a workbook with a single sheet. Every cell is stored as an inline string. The aligns
+// slice holds the horizontal alignment ("left", "center" or "right") of each column; columns without an entry use
+// the default style.
+func Write(w io.Writer, rows [][]string, aligns []string) error {
+	archive := zip.NewWriter(w)
+	parts := []struct {
+		name    string
+		content []byte
+	}{
+		{"[Content_Types].xml", []byte(contentTypes)},
+		{"_rels/.rels", []byte(rootRels)},
+		{"xl/workbook.xml", []byte(workbook)},
+		{"xl/_rels/workbook.xml.rels", []byte(workbookRels)},
+		{"xl/styles.xml", []byte(styles)},
+		{"xl/worksheets/sheet1.xml", sheet(rows, aligns)},
+	}
+
+	for _, part := range parts {
+		file, err := archive.Create(part.name)
+		if err != nil {
+			return err
+		}
+		_, err = file.Write(part.content)
+		if err != nil {
+			return err
+		}
+	}
+	return archive.Close()
+}
+
+func sheet(rows [][]string, aligns []string) []byte {
+	buffer := new(bytes.Buffer)
+	buffer.WriteString(`<?xml version="1.0" encoding="UTF-8" standalone="yes"?>`)
+	buffer.WriteString(`<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>`)
+	for i, row := range rows {
+		fmt.Fprintf(buffer, `<row r="%d">`, i+1)
+		for j, value := range row {
+			style := 0
+			if j < len(aligns) {
+				style = styleIndex(aligns[j])
+			}
+			fmt.Fprintf(buffer, `<c r="%s%d" s="%d" t="inlineStr"><is><t xml:space="preserve">`,
+				ColumnName(j), i+1, style)
+			_ = xml.EscapeText(buffer, []byte(value))
+			buffer.WriteString(`</t></is></c>`)
+		}
+		buffer.WriteString(`</row>`)
+	}
+	buffer.WriteString(`</sheetData></worksheet>`)
+	return buffer.Bytes()
+}
+
+func styleIndex(align string) int {
+	switch align {
+	case "left":
+		return 1
+	case "center":
+		return 2
+	case "right":
+		return 3
+	default:
+		return 0
+	}
+}
+
+// ColumnName converts a zero-based column index to its spreadsheet letters, e.g. 0 is "A" and 26 is "AA".
+func ColumnName(index int) string {
+	name := ""
+	for index >= 0 {
+		name = string(rune('A'+index%26)) + name
+		index = index/26 - 1
+	}
+	return name
+}